@@ -0,0 +1,153 @@
+// Package audit checks the pages of a crawl for common SEO problems, such as
+// missing titles, duplicate descriptions and thin content.
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+)
+
+const (
+	// DefaultMaxURLLength is the length above which a URL is too long.
+	DefaultMaxURLLength = 115
+
+	// DefaultMinWords is the number of words below which a page's content is
+	// thin.
+	DefaultMinWords = 200
+)
+
+// Issue is a kind of problem found on a page.
+type Issue int
+
+// The issues are listed in the order they're reported.
+const (
+	MissingTitle Issue = iota
+	DuplicateTitle
+	MissingDescription
+	DuplicateDescription
+	MissingH1
+	MultipleH1
+	CanonicalElsewhere
+	NoIndex
+	LongURL
+	ThinContent
+)
+
+// Options sets the thresholds of the audit. Zero values use the defaults.
+type Options struct {
+	MaxURLLength int
+	MinWords     int
+}
+
+// Finding is an issue found on a single page. Detail explains the issue, such
+// as the duplicated title or the number of words.
+type Finding struct {
+	URL    string
+	Detail string
+}
+
+// Report is the result of an audit.
+type Report struct {
+	// Pages is the number of pages that were audited.
+	Pages int
+
+	// Findings are the pages with each issue, sorted by their URL. Pages with
+	// duplicate titles and descriptions are grouped by the duplicated value.
+	Findings map[Issue][]Finding
+}
+
+// Audit checks the HTML pages that were fetched successfully for issues.
+// Other pages, like redirects and images, are skipped.
+func Audit(pages []*site.Page, opts Options) Report {
+	if opts.MaxURLLength <= 0 {
+		opts.MaxURLLength = DefaultMaxURLLength
+	}
+	if opts.MinWords <= 0 {
+		opts.MinWords = DefaultMinWords
+	}
+
+	report := Report{Findings: map[Issue][]Finding{}}
+	add := func(issue Issue, p *site.Page, detail string) {
+		report.Findings[issue] = append(report.Findings[issue], Finding{URL: p.URL, Detail: detail})
+	}
+
+	titles := map[string][]*site.Page{}
+	descriptions := map[string][]*site.Page{}
+	for _, p := range pages {
+		if p.Status != http.StatusOK || !strings.Contains(p.ContentType, "text/html") {
+			continue
+		}
+		report.Pages++
+
+		if title := strings.TrimSpace(p.Title); len(title) == 0 {
+			add(MissingTitle, p, "")
+		} else {
+			titles[title] = append(titles[title], p)
+		}
+
+		if len(p.Description) == 0 {
+			add(MissingDescription, p, "")
+		} else {
+			descriptions[p.Description] = append(descriptions[p.Description], p)
+		}
+
+		switch len(p.H1s) {
+		case 0:
+			add(MissingH1, p, "")
+		case 1:
+		default:
+			add(MultipleH1, p, fmt.Sprintf("%d h1 headings", len(p.H1s)))
+		}
+
+		if p.CanonicalElsewhere() {
+			add(CanonicalElsewhere, p, p.Canonical)
+		}
+
+		if p.NoIndex {
+			add(NoIndex, p, "")
+		}
+
+		if len(p.URL) > opts.MaxURLLength {
+			add(LongURL, p, fmt.Sprintf("%d characters", len(p.URL)))
+		}
+
+		if p.Words < opts.MinWords {
+			detail := fmt.Sprintf("%d words", p.Words)
+			if p.Words == 1 {
+				detail = "1 word"
+			}
+			add(ThinContent, p, detail)
+		}
+	}
+
+	duplicates(titles, func(p *site.Page, title string) { add(DuplicateTitle, p, title) })
+	duplicates(descriptions, func(p *site.Page, description string) { add(DuplicateDescription, p, description) })
+
+	for issue, findings := range report.Findings {
+		grouped := issue == DuplicateTitle || issue == DuplicateDescription
+		sort.Slice(findings, func(i, j int) bool {
+			if grouped && findings[i].Detail != findings[j].Detail {
+				return findings[i].Detail < findings[j].Detail
+			}
+			return findings[i].URL < findings[j].URL
+		})
+	}
+
+	return report
+}
+
+// duplicates calls fn for each page that shares its value with another page.
+func duplicates(pages map[string][]*site.Page, fn func(*site.Page, string)) {
+	for val, ps := range pages {
+		if len(ps) < 2 {
+			continue
+		}
+		for _, p := range ps {
+			fn(p, val)
+		}
+	}
+}
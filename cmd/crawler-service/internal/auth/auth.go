@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errMissingToken = errors.New("missing bearer token")
+	errInvalidToken = errors.New("invalid token")
+)
+
+// Authenticator verifies a bearer token and returns the identity it was issued
+// to.
+type Authenticator interface {
+	Authenticate(token string) (*Identity, error)
+}
+
+// Chain is an Authenticator that tries each of its authenticators in order and
+// returns the first identity that is found.
+type Chain []Authenticator
+
+// Authenticate implements Authenticator.
+func (c Chain) Authenticate(token string) (*Identity, error) {
+	err := errInvalidToken
+	for _, a := range c {
+		var id *Identity
+		if id, err = a.Authenticate(token); err == nil {
+			return id, nil
+		}
+	}
+
+	return nil, err
+}
+
+// UnaryServerInterceptor authenticates every unary request using a and
+// attaches the identity to the request's context.
+func UnaryServerInterceptor(a Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates every streaming request using a and
+// attaches the identity to the stream's context.
+func StreamServerInterceptor(a Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), a)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream overrides the context of a grpc.ServerStream so that
+// handlers can find the identity.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate verifies the bearer token in the incoming metadata and returns
+// a context carrying the identity.
+func authenticate(ctx context.Context, a Authenticator) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	id, err := a.Authenticate(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return NewContext(ctx, id), nil
+}
+
+// bearerToken pulls the token out of the authorization metadata.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errMissingToken
+	}
+
+	const prefix = "bearer "
+	if len(values[0]) <= len(prefix) || !strings.EqualFold(values[0][:len(prefix)], prefix) {
+		return "", errMissingToken
+	}
+
+	return values[0][len(prefix):], nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/netpolicy"
+)
+
+// Identity is who made a request and what they are allowed to do.
+type Identity struct {
+	// Subject identifies the user the token was issued to.
+	Subject string
+
+	// Hosts are the hosts the user may crawl, matched by netpolicy.MatchHost
+	// like the network policy's hosts. A pattern may be an exact hostname,
+	// "*.example.com" to match example.com and any of its subdomains, or "*" to
+	// match any host.
+	Hosts []string
+
+	// Admin allows the user to read, stop and delete crawls started by other
+	// users.
+	Admin bool
+}
+
+// CanCrawl reports whether the identity may crawl the given host.
+func (i *Identity) CanCrawl(host string) bool {
+	for _, pattern := range i.Hosts {
+		if netpolicy.MatchHost(pattern, host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanRead reports whether the identity may read the results of a crawl started
+// by owner, or replay what it archived.
+func (i *Identity) CanRead(owner string) bool {
+	return i.Admin || i.Subject == owner
+}
+
+// CanManage reports whether the identity may stop or delete a crawl started by
+// owner.
+func (i *Identity) CanManage(owner string) bool {
+	return i.Admin || i.Subject == owner
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries the identity.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity stored in ctx. False is returned if the
+// request was not authenticated, which is the case when authentication is
+// disabled.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(*Identity)
+	return id, ok
+}
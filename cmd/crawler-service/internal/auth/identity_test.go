@@ -0,0 +1,28 @@
+package auth
+
+import "testing"
+
+func TestIdentityCanCrawl(t *testing.T) {
+	tests := []struct {
+		hosts []string
+		host  string
+		want  bool
+	}{
+		{hosts: []string{"example.com"}, host: "example.com", want: true},
+		{hosts: []string{"example.com"}, host: "EXAMPLE.com.", want: true},
+		{hosts: []string{"example.com"}, host: "www.example.com", want: false},
+		{hosts: []string{"*.example.com"}, host: "www.example.com", want: true},
+		{hosts: []string{"*.example.com"}, host: "example.com", want: true},
+		{hosts: []string{"*.example.com"}, host: "badexample.com", want: false},
+		{hosts: []string{"*"}, host: "example.org", want: true},
+		{hosts: []string{"example.org", "*.example.com"}, host: "a.example.com", want: true},
+		{hosts: nil, host: "example.com", want: false},
+	}
+
+	for _, test := range tests {
+		id := &Identity{Subject: "alice", Hosts: test.hosts}
+		if got := id.CanCrawl(test.host); got != test.want {
+			t.Errorf("CanCrawl(%q) with hosts %q = %v, want %v", test.host, test.hosts, got, test.want)
+		}
+	}
+}
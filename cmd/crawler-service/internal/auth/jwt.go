@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// JWT is an Authenticator that verifies HS256 signed JSON Web Tokens with a
+// shared secret. The token's claims must contain a "sub", and may contain
+// "exp" and "nbf" as well as the crawler specific "hosts" and "admin" claims.
+type JWT struct {
+	secret []byte
+}
+
+// NewJWT returns a JWT authenticator that verifies tokens signed with secret.
+func NewJWT(secret []byte) *JWT {
+	return &JWT{secret: secret}
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	Hosts     []string `json:"hosts"`
+	Admin     bool     `json:"admin"`
+}
+
+// Authenticate implements Authenticator.
+func (j *JWT) Authenticate(token string) (*Identity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errInvalidToken
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+
+	// Only accept the algorithm we sign with, otherwise a token could claim to
+	// be unsigned ("none").
+	if header.Alg != "HS256" {
+		return nil, errors.Errorf("unsupported signing algorithm %q", header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+
+	mac := hmac.New(sha256.New, j.secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errInvalidToken
+	}
+
+	var claims jwtClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	if claims.ExpiresAt != 0 && now >= claims.ExpiresAt {
+		return nil, errors.New("token has expired")
+	}
+
+	if claims.NotBefore != 0 && now < claims.NotBefore {
+		return nil, errors.New("token is not valid yet")
+	}
+
+	if len(claims.Subject) == 0 {
+		return nil, errors.New("token is missing the sub claim")
+	}
+
+	return &Identity{Subject: claims.Subject, Hosts: claims.Hosts, Admin: claims.Admin}, nil
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a JWT into v.
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return errInvalidToken
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return errInvalidToken
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// encodeSegment encodes v as a base64url encoded JSON segment of a JWT.
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to encode %v: %v", v, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// signJWT returns a token with the given header and claims, signed with secret
+// using HS256 whatever the header's alg says.
+func signJWT(t *testing.T, secret string, header, claims map[string]interface{}) string {
+	t.Helper()
+
+	unsigned := encodeSegment(t, header) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestJWTAuthenticate(t *testing.T) {
+	const secret = "secret"
+	hs256 := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+	past := time.Now().Add(-time.Hour).Unix()
+	future := time.Now().Add(time.Hour).Unix()
+
+	valid := signJWT(t, secret, hs256, map[string]interface{}{"sub": "alice"})
+	segments := strings.Split(valid, ".")
+
+	tests := []struct {
+		name  string
+		token string
+		want  *Identity
+	}{
+		{
+			name:  "valid",
+			token: valid,
+			want:  &Identity{Subject: "alice"},
+		},
+		{
+			name:  "hosts and admin claims",
+			token: signJWT(t, secret, hs256, map[string]interface{}{"sub": "alice", "hosts": []string{"*.example.com"}, "admin": true}),
+			want:  &Identity{Subject: "alice", Hosts: []string{"*.example.com"}, Admin: true},
+		},
+		{
+			name:  "exp in the future",
+			token: signJWT(t, secret, hs256, map[string]interface{}{"sub": "alice", "exp": future}),
+			want:  &Identity{Subject: "alice"},
+		},
+		{
+			name:  "exp in the past",
+			token: signJWT(t, secret, hs256, map[string]interface{}{"sub": "alice", "exp": past}),
+		},
+		{
+			name:  "nbf in the past",
+			token: signJWT(t, secret, hs256, map[string]interface{}{"sub": "alice", "nbf": past}),
+			want:  &Identity{Subject: "alice"},
+		},
+		{
+			name:  "nbf in the future",
+			token: signJWT(t, secret, hs256, map[string]interface{}{"sub": "alice", "nbf": future}),
+		},
+		{
+			name:  "missing sub",
+			token: signJWT(t, secret, hs256, map[string]interface{}{"admin": true}),
+		},
+		{
+			name:  "alg none",
+			token: signJWT(t, secret, map[string]interface{}{"alg": "none"}, map[string]interface{}{"sub": "alice"}),
+		},
+		{
+			name:  "alg none without a signature",
+			token: encodeSegment(t, map[string]interface{}{"alg": "none"}) + "." + segments[1] + ".",
+		},
+		{
+			name:  "without a signature",
+			token: segments[0] + "." + segments[1] + ".",
+		},
+		{
+			name:  "alg HS512",
+			token: signJWT(t, secret, map[string]interface{}{"alg": "HS512"}, map[string]interface{}{"sub": "alice"}),
+		},
+		{
+			name:  "missing alg",
+			token: signJWT(t, secret, map[string]interface{}{}, map[string]interface{}{"sub": "alice"}),
+		},
+		{
+			name:  "signed with another secret",
+			token: signJWT(t, "other", hs256, map[string]interface{}{"sub": "alice"}),
+		},
+		{
+			name:  "claims changed after signing",
+			token: segments[0] + "." + encodeSegment(t, map[string]interface{}{"sub": "alice", "admin": true}) + "." + segments[2],
+		},
+		{
+			name:  "too few segments",
+			token: "a.b",
+		},
+		{
+			name:  "too many segments",
+			token: valid + ".extra",
+		},
+		{
+			name:  "invalid base64",
+			token: "!!!." + segments[1] + "." + segments[2],
+		},
+		{
+			name:  "empty",
+			token: "",
+		},
+	}
+
+	j := NewJWT([]byte(secret))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := j.Authenticate(test.token)
+			if test.want == nil {
+				if err == nil {
+					t.Fatalf("Authenticate() = %+v, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Authenticate() returned an error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Authenticate() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
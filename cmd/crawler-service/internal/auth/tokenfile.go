@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// TokenFile is an Authenticator backed by a static list of tokens.
+type TokenFile struct {
+	Tokens []Token `json:"tokens"`
+}
+
+// Token is a single static token and the identity it grants.
+type Token struct {
+	Token   string   `json:"token"`
+	Subject string   `json:"subject"`
+	Hosts   []string `json:"hosts"`
+	Admin   bool     `json:"admin"`
+}
+
+// LoadTokenFile reads a JSON token file in the following format:
+//
+//	{"tokens": [{"token": "s3cret", "subject": "alice", "hosts": ["*.example.com"], "admin": false}]}
+func LoadTokenFile(path string) (*TokenFile, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read token file %s", path)
+	}
+
+	var f TokenFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse token file %s", path)
+	}
+
+	for i, t := range f.Tokens {
+		if len(t.Token) == 0 || len(t.Subject) == 0 {
+			return nil, errors.Errorf("token %d in %s needs both a token and a subject", i, path)
+		}
+	}
+
+	return &f, nil
+}
+
+// Authenticate implements Authenticator.
+func (f *TokenFile) Authenticate(token string) (*Identity, error) {
+	// Compare against every token in constant time so that we don't leak how
+	// much of a token matched.
+	var found *Token
+	for i := range f.Tokens {
+		if subtle.ConstantTimeCompare([]byte(f.Tokens[i].Token), []byte(token)) == 1 {
+			found = &f.Tokens[i]
+		}
+	}
+
+	if found == nil {
+		return nil, errInvalidToken
+	}
+
+	return &Identity{Subject: found.Subject, Hosts: found.Hosts, Admin: found.Admin}, nil
+}
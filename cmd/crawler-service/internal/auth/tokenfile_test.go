@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestLoadTokenFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		want     []Token
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			contents: `{"tokens": [{"token": "s3cret", "subject": "alice", "hosts": ["*.example.com"], "admin": true}]}`,
+			want:     []Token{{Token: "s3cret", Subject: "alice", Hosts: []string{"*.example.com"}, Admin: true}},
+		},
+		{
+			name:     "no tokens",
+			contents: `{"tokens": []}`,
+			want:     []Token{},
+		},
+		{
+			name:     "missing token",
+			contents: `{"tokens": [{"subject": "alice"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "missing subject",
+			contents: `{"tokens": [{"token": "s3cret"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid JSON",
+			contents: `{"tokens": [`,
+			wantErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := ioutil.TempFile("", "tokens")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			if _, err := f.WriteString(test.contents); err != nil {
+				t.Fatal(err)
+			}
+			f.Close()
+
+			got, err := LoadTokenFile(f.Name())
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("LoadTokenFile() = %+v, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("LoadTokenFile() returned an error: %v", err)
+			}
+			if !reflect.DeepEqual(got.Tokens, test.want) {
+				t.Errorf("LoadTokenFile() = %+v, want %+v", got.Tokens, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadTokenFileMissing(t *testing.T) {
+	if _, err := LoadTokenFile("does-not-exist.json"); err == nil {
+		t.Error("LoadTokenFile() of a missing file didn't return an error")
+	}
+}
+
+func TestTokenFileAuthenticate(t *testing.T) {
+	f := &TokenFile{Tokens: []Token{
+		{Token: "alice-token", Subject: "alice", Hosts: []string{"example.com"}},
+		{Token: "admin-token", Subject: "root", Admin: true},
+	}}
+
+	tests := []struct {
+		name  string
+		token string
+		want  *Identity
+	}{
+		{
+			name:  "first token",
+			token: "alice-token",
+			want:  &Identity{Subject: "alice", Hosts: []string{"example.com"}},
+		},
+		{
+			name:  "second token",
+			token: "admin-token",
+			want:  &Identity{Subject: "root", Admin: true},
+		},
+		{
+			name:  "prefix of a token",
+			token: "alice",
+		},
+		{
+			name:  "token with a suffix",
+			token: "alice-token2",
+		},
+		{
+			name:  "different case",
+			token: "ALICE-TOKEN",
+		},
+		{
+			name:  "unknown token",
+			token: "mallory-token",
+		},
+		{
+			name:  "empty",
+			token: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := f.Authenticate(test.token)
+			if test.want == nil {
+				if err == nil {
+					t.Fatalf("Authenticate() = %+v, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Authenticate() returned an error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Authenticate() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
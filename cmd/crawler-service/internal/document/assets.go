@@ -0,0 +1,176 @@
+package document
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// AssetType is the kind of resource that an asset is.
+type AssetType string
+
+// Asset types.
+const (
+	Image      AssetType = "image"
+	Script     AssetType = "script"
+	Stylesheet AssetType = "stylesheet"
+	Media      AssetType = "media"
+)
+
+// Asset is a resource that the document uses, like an image or a script.
+type Asset struct {
+	URL  *url.URL
+	Type AssetType
+}
+
+// Assets returns the resources that the document's elements reference, in
+// document order, resolved against the document's URL. Every candidate in a
+// srcset is included. An asset that is referenced more than once is returned
+// each time.
+func (d *Document) Assets() []Asset {
+	var assets []Asset
+	d.walkReferences(func(n *html.Node, ref reference) {
+		if ref.asset == "" {
+			return
+		}
+
+		val, _ := attr(n, ref.key)
+		for _, raw := range ref.urls(val) {
+			if u, err := d.url.Parse(raw); err == nil {
+				assets = append(assets, Asset{URL: u, Type: ref.asset})
+			}
+		}
+	})
+
+	return assets
+}
+
+// RewriteURLs replaces the links and asset URLs in the document with the
+// result of calling fn with each resolved URL. The original value is kept if
+// fn returns an empty string.
+func (d *Document) RewriteURLs(fn func(u *url.URL) string) {
+	d.walkReferences(func(n *html.Node, ref reference) {
+		for i, a := range n.Attr {
+			if a.Namespace != "" || a.Key != ref.key {
+				continue
+			}
+
+			n.Attr[i].Val = ref.rewrite(a.Val, func(raw string) string {
+				u, err := d.url.Parse(raw)
+				if len(raw) == 0 || err != nil {
+					return raw
+				}
+
+				if rewritten := fn(u); len(rewritten) > 0 {
+					return rewritten
+				}
+				return raw
+			})
+		}
+	})
+}
+
+// Render writes the document as HTML.
+func (d *Document) Render(w io.Writer) error {
+	return html.Render(w, d.root)
+}
+
+// reference is an attribute of an element that refers to another URL.
+type reference struct {
+	key string
+
+	// asset is the type of the referenced resource, or empty for links to
+	// other pages.
+	asset AssetType
+
+	// srcset is true if the attribute is a list of image candidates.
+	srcset bool
+}
+
+// urls returns the URLs in the attribute's value.
+func (ref reference) urls(val string) []string {
+	if !ref.srcset {
+		if val = strings.TrimSpace(val); len(val) == 0 {
+			return nil
+		}
+		return []string{val}
+	}
+
+	var urls []string
+	for _, candidate := range strings.Split(val, ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			urls = append(urls, fields[0])
+		}
+	}
+
+	return urls
+}
+
+// rewrite replaces the URLs in the attribute's value with the result of fn.
+func (ref reference) rewrite(val string, fn func(string) string) string {
+	if !ref.srcset {
+		return fn(strings.TrimSpace(val))
+	}
+
+	candidates := strings.Split(val, ",")
+	for i, candidate := range candidates {
+		fields := strings.Fields(candidate)
+		if len(fields) == 0 {
+			continue
+		}
+		fields[0] = fn(fields[0])
+		candidates[i] = strings.Join(fields, " ")
+	}
+
+	return strings.Join(candidates, ", ")
+}
+
+// walkReferences calls fn for every attribute in the document that refers to
+// another URL.
+func (d *Document) walkReferences(fn func(n *html.Node, ref reference)) {
+	d.walk(func(n *html.Node) {
+		for _, ref := range references(n) {
+			if _, ok := attr(n, ref.key); ok {
+				fn(n, ref)
+			}
+		}
+	})
+}
+
+// references returns the attributes of n that may refer to another URL.
+func references(n *html.Node) []reference {
+	switch n.DataAtom {
+	case atom.A:
+		return []reference{{key: "href"}}
+	case atom.Img:
+		return []reference{{key: "src", asset: Image}, {key: "srcset", asset: Image, srcset: true}}
+	case atom.Script:
+		return []reference{{key: "src", asset: Script}}
+	case atom.Link:
+		rel, _ := attr(n, "rel")
+		for _, r := range strings.Fields(strings.ToLower(rel)) {
+			switch r {
+			case "stylesheet":
+				return []reference{{key: "href", asset: Stylesheet}}
+			case "icon", "apple-touch-icon":
+				return []reference{{key: "href", asset: Image}}
+			}
+		}
+	case atom.Video:
+		return []reference{{key: "src", asset: Media}, {key: "poster", asset: Image}}
+	case atom.Audio, atom.Track:
+		return []reference{{key: "src", asset: Media}}
+	case atom.Source:
+		// A <source> in a <picture> is an image, otherwise it is for a <video>
+		// or <audio>.
+		if n.Parent != nil && n.Parent.DataAtom == atom.Picture {
+			return []reference{{key: "srcset", asset: Image, srcset: true}}
+		}
+		return []reference{{key: "src", asset: Media}, {key: "srcset", asset: Image, srcset: true}}
+	}
+
+	return nil
+}
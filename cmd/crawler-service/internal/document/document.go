@@ -0,0 +1,142 @@
+package document
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Document is a parsed HTML page.
+type Document struct {
+	root *html.Node
+
+	// url is the URL the document was fetched from. Relative URLs in the
+	// document are resolved against it.
+	url *url.URL
+}
+
+// Parse parses the HTML in r, which was fetched from u.
+func Parse(r io.Reader, u *url.URL) (*Document, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{root: root, url: u}, nil
+}
+
+// Title returns the text of the document's <title>, with its whitespace
+// collapsed.
+func (d *Document) Title() string {
+	title := d.find(func(n *html.Node) bool { return n.DataAtom == atom.Title })
+	if title == nil {
+		return ""
+	}
+
+	return strings.Join(strings.Fields(textContent(title)), " ")
+}
+
+// Anchor is a link from an <a> element. Nofollow is true if the element has
+// rel="nofollow".
+type Anchor struct {
+	URL      *url.URL
+	Nofollow bool
+}
+
+// Anchors returns the links of every <a> in the document that has an href,
+// resolved against the document's URL, in document order. Hrefs that can't be
+// parsed are skipped.
+func (d *Document) Anchors() []Anchor {
+	var anchors []Anchor
+	d.walk(func(n *html.Node) {
+		if n.DataAtom != atom.A {
+			return
+		}
+
+		if link, ok := d.resolveAttr(n, "href"); ok {
+			anchors = append(anchors, Anchor{URL: link, Nofollow: hasRel(n, "nofollow")})
+		}
+	})
+
+	return anchors
+}
+
+// resolveAttr parses the attribute of n as a URL relative to the document.
+func (d *Document) resolveAttr(n *html.Node, key string) (*url.URL, bool) {
+	val, ok := attr(n, key)
+	if !ok {
+		return nil, false
+	}
+
+	u, err := d.url.Parse(strings.TrimSpace(val))
+	if err != nil {
+		return nil, false
+	}
+
+	return u, true
+}
+
+// walk calls fn for every element in the document in document order.
+func (d *Document) walk(fn func(n *html.Node)) {
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			fn(n)
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	visit(d.root)
+}
+
+// find returns the first element that matches, or nil if none do.
+func (d *Document) find(match func(n *html.Node) bool) *html.Node {
+	var found *html.Node
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		for ; n != nil && found == nil; n = n.NextSibling {
+			if n.Type == html.ElementNode && match(n) {
+				found = n
+				return
+			}
+			visit(n.FirstChild)
+		}
+	}
+	visit(d.root)
+
+	return found
+}
+
+// attr returns the value of the attribute with the given key.
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Namespace == "" && a.Key == key {
+			return a.Val, true
+		}
+	}
+
+	return "", false
+}
+
+// textContent returns the text of n and all of its descendants.
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	visit(n)
+
+	return sb.String()
+}
@@ -0,0 +1,178 @@
+package document
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Meta returns the content of the first <meta> with the given name, which is
+// matched case insensitively.
+func (d *Document) Meta(name string) (string, bool) {
+	meta := d.find(func(n *html.Node) bool {
+		if n.DataAtom != atom.Meta {
+			return false
+		}
+		val, _ := attr(n, "name")
+		return strings.EqualFold(strings.TrimSpace(val), name)
+	})
+	if meta == nil {
+		return "", false
+	}
+
+	return attr(meta, "content")
+}
+
+// Description returns the content of the document's meta description, with
+// its whitespace collapsed.
+func (d *Document) Description() string {
+	description, _ := d.Meta("description")
+	return strings.Join(strings.Fields(description), " ")
+}
+
+// Robots returns the directives in the document's robots meta tags, like
+// noindex and nofollow, in lower case.
+func (d *Document) Robots() []string {
+	var directives []string
+	d.walk(func(n *html.Node) {
+		if n.DataAtom != atom.Meta {
+			return
+		}
+
+		name, _ := attr(n, "name")
+		if !strings.EqualFold(strings.TrimSpace(name), "robots") {
+			return
+		}
+
+		content, _ := attr(n, "content")
+		directives = append(directives, ParseRobots(content)...)
+	})
+
+	return directives
+}
+
+// ParseRobots splits a comma separated list of robots directives, as used by
+// robots meta tags and the X-Robots-Tag header, into lower case directives.
+func ParseRobots(val string) []string {
+	var directives []string
+	for _, d := range strings.Split(val, ",") {
+		if d = strings.ToLower(strings.TrimSpace(d)); len(d) > 0 {
+			directives = append(directives, d)
+		}
+	}
+
+	return directives
+}
+
+// Headings returns the text of each heading of the given level, from 1 to 6,
+// with its whitespace collapsed.
+func (d *Document) Headings(level int) []string {
+	tags := []atom.Atom{atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6}
+	if level < 1 || level > len(tags) {
+		return nil
+	}
+
+	var headings []string
+	d.walk(func(n *html.Node) {
+		if n.DataAtom == tags[level-1] {
+			headings = append(headings, strings.Join(strings.Fields(textContent(n)), " "))
+		}
+	})
+
+	return headings
+}
+
+// Canonical returns the URL of the document's <link rel="canonical">,
+// resolved against the document's URL.
+func (d *Document) Canonical() (*url.URL, bool) {
+	link := d.find(func(n *html.Node) bool {
+		return n.DataAtom == atom.Link && hasRel(n, "canonical")
+	})
+	if link == nil {
+		return nil, false
+	}
+
+	return d.resolveAttr(link, "href")
+}
+
+// Alternate is a <link rel="alternate"> to a version of the document in
+// another language, given by Lang, like "en-gb" or "x-default".
+type Alternate struct {
+	Lang string
+	URL  *url.URL
+}
+
+// Alternates returns the document's links to versions of itself in other
+// languages, resolved against the document's URL.
+func (d *Document) Alternates() []Alternate {
+	var alternates []Alternate
+	d.walk(func(n *html.Node) {
+		if n.DataAtom != atom.Link || !hasRel(n, "alternate") {
+			return
+		}
+
+		lang, ok := attr(n, "hreflang")
+		if !ok || len(strings.TrimSpace(lang)) == 0 {
+			return
+		}
+
+		if u, ok := d.resolveAttr(n, "href"); ok {
+			alternates = append(alternates, Alternate{Lang: strings.ToLower(strings.TrimSpace(lang)), URL: u})
+		}
+	})
+
+	return alternates
+}
+
+// Text returns the text of the document's body that is visible to a reader,
+// with its whitespace collapsed. Scripts, styles and templates are left out.
+func (d *Document) Text() string {
+	body := d.find(func(n *html.Node) bool { return n.DataAtom == atom.Body })
+	if body == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			sb.WriteString(n.Data)
+			sb.WriteString(" ")
+		case n.Type == html.ElementNode && hidden(n):
+			return
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	visit(body)
+
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// hidden returns true for elements whose content isn't shown to a reader.
+func hidden(n *html.Node) bool {
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Noscript, atom.Template:
+		return true
+	}
+
+	_, ok := attr(n, "hidden")
+	return ok
+}
+
+// hasRel returns true if the element's rel attribute contains the value.
+func hasRel(n *html.Node, val string) bool {
+	rel, _ := attr(n, "rel")
+	for _, r := range strings.Fields(rel) {
+		if strings.EqualFold(r, val) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,214 @@
+package document
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Property is a <meta> tag's property, or name, and its content.
+type Property struct {
+	Name    string
+	Content string
+}
+
+// Item is a Microdata item, an element with an itemscope attribute.
+type Item struct {
+	// Types are the URLs in the item's itemtype attribute, and ID is its
+	// itemid.
+	Types []string
+	ID    string
+
+	Properties []ItemProperty
+}
+
+// ItemProperty is a property of a Microdata item. Item is set if the
+// property's value is another item, otherwise Value is its text value.
+type ItemProperty struct {
+	Name  string
+	Value string
+	Item  *Item
+}
+
+// JSONLD returns the contents of the document's JSON-LD scripts.
+func (d *Document) JSONLD() []string {
+	var scripts []string
+	d.walk(func(n *html.Node) {
+		if n.DataAtom != atom.Script {
+			return
+		}
+
+		typ, _ := attr(n, "type")
+		if strings.EqualFold(strings.TrimSpace(typ), "application/ld+json") {
+			scripts = append(scripts, textContent(n))
+		}
+	})
+
+	return scripts
+}
+
+// MetaProperties returns the <meta> tags whose property or name starts with
+// prefix, like "og:" for OpenGraph or "twitter:" for Twitter cards, in
+// document order.
+func (d *Document) MetaProperties(prefix string) []Property {
+	var props []Property
+	d.walk(func(n *html.Node) {
+		if n.DataAtom != atom.Meta {
+			return
+		}
+
+		name, ok := attr(n, "property")
+		if !ok {
+			name, _ = attr(n, "name")
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !strings.HasPrefix(name, prefix) {
+			return
+		}
+
+		content, _ := attr(n, "content")
+		props = append(props, Property{Name: name, Content: strings.TrimSpace(content)})
+	})
+
+	return props
+}
+
+// Microdata returns the document's top level Microdata items, those that
+// aren't the property of another item. Properties referenced with itemref
+// aren't supported.
+func (d *Document) Microdata() []Item {
+	var items []Item
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		if n.Type == html.ElementNode && hasAttr(n, "itemscope") && !hasAttr(n, "itemprop") {
+			items = append(items, d.item(n))
+		}
+
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	visit(d.root)
+
+	return items
+}
+
+// item reads the Microdata item of an element with an itemscope attribute.
+func (d *Document) item(n *html.Node) Item {
+	types, _ := attr(n, "itemtype")
+	id, _ := attr(n, "itemid")
+	item := Item{Types: strings.Fields(types), ID: strings.TrimSpace(id)}
+
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+
+			names, isProp := attr(c, "itemprop")
+			scope := hasAttr(c, "itemscope")
+			if isProp {
+				prop := ItemProperty{}
+				if scope {
+					nested := d.item(c)
+					prop.Item = &nested
+				} else {
+					prop.Value = d.itemValue(c)
+				}
+
+				// An element can be several properties at once.
+				for _, name := range strings.Fields(names) {
+					prop.Name = name
+					item.Properties = append(item.Properties, prop)
+				}
+			}
+
+			// The properties of a nested item belong to it, not to us.
+			if !scope {
+				visit(c)
+			}
+		}
+	}
+	visit(n)
+
+	return item
+}
+
+// itemValue returns the value of a Microdata property that isn't an item,
+// which depends on the element it's on.
+func (d *Document) itemValue(n *html.Node) string {
+	var key string
+	switch n.DataAtom {
+	case atom.Meta:
+		key = "content"
+	case atom.Audio, atom.Embed, atom.Iframe, atom.Img, atom.Source, atom.Track, atom.Video:
+		key = "src"
+	case atom.A, atom.Area, atom.Link:
+		key = "href"
+	case atom.Object:
+		key = "data"
+	case atom.Data, atom.Meter:
+		key = "value"
+	case atom.Time:
+		if val, ok := attr(n, "datetime"); ok {
+			return strings.TrimSpace(val)
+		}
+	}
+
+	if len(key) == 0 {
+		return strings.Join(strings.Fields(textContent(n)), " ")
+	}
+
+	// URLs are resolved against the document.
+	if key == "src" || key == "href" || key == "data" {
+		if u, ok := d.resolveAttr(n, key); ok {
+			return u.String()
+		}
+		return ""
+	}
+
+	val, _ := attr(n, key)
+	return strings.TrimSpace(val)
+}
+
+// hasAttr returns true if the element has the attribute, whatever its value.
+func hasAttr(n *html.Node, key string) bool {
+	_, ok := attr(n, key)
+	return ok
+}
+
+// Matcher matches elements, like a compiled CSS selector.
+type Matcher interface {
+	Match(n *html.Node) bool
+}
+
+// Select returns a value from each element that m matches, in document order.
+// The value is the element's text with its whitespace collapsed if attribute is
+// empty, and otherwise the attribute's value. href and src attributes are
+// resolved against the document's URL. Elements without the attribute are
+// skipped.
+func (d *Document) Select(m Matcher, attribute string) []string {
+	var values []string
+	d.walk(func(n *html.Node) {
+		if !m.Match(n) {
+			return
+		}
+
+		switch attribute {
+		case "":
+			values = append(values, strings.Join(strings.Fields(textContent(n)), " "))
+		case "href", "src":
+			if u, ok := d.resolveAttr(n, attribute); ok {
+				values = append(values, u.String())
+			}
+		default:
+			if val, ok := attr(n, attribute); ok {
+				values = append(values, strings.TrimSpace(val))
+			}
+		}
+	})
+
+	return values
+}
@@ -0,0 +1,72 @@
+// Package extract pulls data out of pages using rules made of CSS selectors.
+package extract
+
+import (
+	"regexp"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/document"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+)
+
+// Rule extracts a named field from the elements of a page that match a CSS
+// selector.
+type Rule struct {
+	Name string
+
+	// Attribute is the attribute whose value is extracted from each element,
+	// or empty to extract the element's text.
+	Attribute string
+
+	selector cascadia.Selector
+
+	// pattern, if set, is matched against a page's URL to decide whether the
+	// rule applies to it.
+	pattern *regexp.Regexp
+}
+
+// NewRule compiles a rule. The rule applies to every page if urlPattern is
+// empty, otherwise only to the pages whose URL matches the regular expression.
+func NewRule(name, selector, attribute, urlPattern string) (*Rule, error) {
+	if len(name) == 0 {
+		return nil, errors.New("the rule doesn't have a name")
+	}
+
+	sel, err := cascadia.Compile(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "rule %s has an invalid selector", name)
+	}
+
+	r := &Rule{Name: name, Attribute: attribute, selector: sel}
+	if len(urlPattern) > 0 {
+		if r.pattern, err = regexp.Compile(urlPattern); err != nil {
+			return nil, errors.Wrapf(err, "rule %s has an invalid URL pattern", name)
+		}
+	}
+
+	return r, nil
+}
+
+// Applies returns true if the rule should be run on the page at the URL.
+func (r *Rule) Applies(u string) bool {
+	return r.pattern == nil || r.pattern.MatchString(u)
+}
+
+// Extract runs the rules that apply to the page at the URL, and returns a field
+// for each of them in the order of the rules. It returns false if none of the
+// rules apply.
+func Extract(rules []*Rule, u string, doc *document.Document) ([]site.Field, bool) {
+	var fields []site.Field
+	var applied bool
+	for _, r := range rules {
+		if !r.Applies(u) {
+			continue
+		}
+
+		applied = true
+		fields = append(fields, site.Field{Name: r.Name, Values: doc.Select(r.selector, r.Attribute)})
+	}
+
+	return fields, applied
+}
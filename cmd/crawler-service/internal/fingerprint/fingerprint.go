@@ -0,0 +1,73 @@
+// Package fingerprint computes fingerprints of the text of pages, which are
+// used to find pages with the same or nearly the same content.
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// DefaultMaxDistance is the most bits by which the SimHashes of two pages may
+// differ for them to be near duplicates.
+const DefaultMaxDistance = 3
+
+// shingleSize is the number of words in each of the overlapping runs of words
+// that make up a SimHash.
+const shingleSize = 3
+
+// Hash returns the hex encoded SHA-256 of the text after its whitespace has
+// been collapsed and it has been lower cased, so that pages with the same
+// words have the same hash.
+func Hash(text string) string {
+	sum := sha256.Sum256([]byte(strings.Join(words(text), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// SimHash returns a 64 bit SimHash of the text. Texts that share most of their
+// runs of words have SimHashes that differ by only a few bits.
+func SimHash(text string) uint64 {
+	w := words(text)
+	if len(w) == 0 {
+		return 0
+	}
+
+	var weights [64]int
+	for i := 0; i+shingleSize <= len(w) || i == 0; i++ {
+		end := i + shingleSize
+		if end > len(w) {
+			end = len(w)
+		}
+
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(w[i:end], " ")))
+		sum := h.Sum64()
+		for bit := range weights {
+			if sum&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var simhash uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			simhash |= 1 << uint(bit)
+		}
+	}
+
+	return simhash
+}
+
+// Distance returns the number of bits by which two SimHashes differ.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+func words(text string) []string {
+	return strings.Fields(strings.ToLower(text))
+}
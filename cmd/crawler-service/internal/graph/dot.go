@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDOT writes the graph in the Graphviz DOT language. Links between pages
+// are drawn as dashed edges, and collapsed nodes with a dashed border.
+func WriteDOT(w io.Writer, g *Graph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph site {")
+	fmt.Fprintln(bw, "  rankdir=LR;")
+	fmt.Fprintln(bw, `  node [shape=box, style="rounded", fontname="Helvetica"];`)
+
+	for _, n := range g.Nodes {
+		attrs := []string{"label=" + dotQuote(n.Label)}
+		if len(n.URL) > 0 {
+			attrs = append(attrs, "URL="+dotQuote(n.URL), "tooltip="+dotQuote(fmt.Sprintf("%d %s", n.Status, n.URL)))
+		}
+
+		style := "rounded"
+		if n.Collapsed > 0 {
+			style += ",dashed"
+		}
+		if len(n.Color) > 0 {
+			style += ",filled"
+			attrs = append(attrs, "fillcolor="+dotQuote(n.Color))
+		}
+		if style != "rounded" {
+			attrs = append(attrs, "style="+dotQuote(style))
+		}
+
+		fmt.Fprintf(bw, "  %s [%s];\n", n.ID, strings.Join(attrs, ", "))
+	}
+
+	for _, e := range g.Edges {
+		if e.Link {
+			fmt.Fprintf(bw, "  %s -> %s [style=dashed, color=\"#757575\"];\n", e.From, e.To)
+			continue
+		}
+		fmt.Fprintf(bw, "  %s -> %s;\n", e.From, e.To)
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// dotQuote returns s as a double quoted DOT string.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
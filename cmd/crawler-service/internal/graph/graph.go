@@ -0,0 +1,243 @@
+// Package graph builds a graph of a crawled site from its site tree and the
+// links between its pages, and writes it in formats that can be visualized:
+// Graphviz DOT, GraphML and Mermaid flowcharts.
+package graph
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+)
+
+// Options configures how a graph is built.
+type Options struct {
+	// MaxDepth is the deepest level of the site tree that is included, where
+	// the root is at depth 0. Deeper pages are folded into their ancestor. A
+	// value <= 0 includes every level.
+	MaxDepth int
+
+	// CollapseSiblings is the most children a node is drawn with. The rest are
+	// collapsed into a single node that counts them. A value <= 0 doesn't
+	// collapse any children.
+	CollapseSiblings int
+
+	// ColorByStatus colors the nodes by the HTTP status of their page.
+	ColorByStatus bool
+
+	// Links adds an edge for each link between pages, as well as the edges of
+	// the site tree.
+	Links bool
+}
+
+// Graph is a directed graph of a site.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// Node is a path in the site tree.
+type Node struct {
+	ID    string
+	Label string
+
+	// URL and Status describe the page at the node's path. They are empty if
+	// the path wasn't fetched, like a directory that was never linked to.
+	URL    string
+	Status int
+
+	// Collapsed is the number of sibling paths the node stands in for, or 0 if
+	// it is a single path.
+	Collapsed int
+
+	// Color is the color the node is filled with, as a hex RGB string. It is
+	// empty if the node isn't colored.
+	Color string
+}
+
+// Edge connects a node to one of its children in the site tree, or to a node
+// that it links to.
+type Edge struct {
+	From, To string
+	Link     bool
+}
+
+// Build creates a graph from a site tree and the pages of the crawl keyed by
+// their path.
+func Build(tree site.Tree, pages map[string]*site.Page, opts Options) *Graph {
+	b := &builder{
+		graph: &Graph{},
+		pages: pages,
+		opts:  opts,
+		paths: map[string]string{},
+		edges: map[Edge]bool{},
+	}
+
+	b.addTree(&tree, "/", 0, "")
+	if opts.Links {
+		b.addLinks()
+	}
+
+	return b.graph
+}
+
+type builder struct {
+	graph *Graph
+	pages map[string]*site.Page
+	opts  Options
+
+	// paths maps every path in the tree to the node that it is drawn as.
+	paths map[string]string
+	edges map[Edge]bool
+}
+
+// addTree adds a node for the tree, and then for its children unless they are
+// too deep or need to be collapsed.
+func (b *builder) addTree(t *site.Tree, path string, depth int, parent string) {
+	node := Node{Label: t.Value}
+	if page := b.page(path); page != nil {
+		node.URL = page.URL
+		node.Status = page.Status
+		if b.opts.ColorByStatus {
+			node.Color = StatusColor(page.Status)
+		}
+	}
+
+	id := b.addNode(node, parent)
+	b.paths[path] = id
+
+	children := make([]*site.Tree, 0, len(t.Children))
+	for _, child := range t.Children {
+		// The root page is added to the tree as an empty child of the root, so
+		// it is already drawn by this node.
+		if len(child.Value) == 0 {
+			continue
+		}
+		children = append(children, child)
+	}
+
+	if b.opts.MaxDepth > 0 && depth >= b.opts.MaxDepth {
+		for _, child := range children {
+			b.fold(child, childPath(path, child.Value), id)
+		}
+		return
+	}
+
+	var rest []*site.Tree
+	if b.opts.CollapseSiblings > 0 && len(children) > b.opts.CollapseSiblings {
+		rest = children[b.opts.CollapseSiblings:]
+		children = children[:b.opts.CollapseSiblings]
+	}
+
+	for _, child := range children {
+		b.addTree(child, childPath(path, child.Value), depth+1, id)
+	}
+
+	if len(rest) > 0 {
+		collapsed := b.addNode(Node{Label: fmt.Sprintf("%d more", len(rest)), Collapsed: len(rest)}, id)
+		for _, child := range rest {
+			b.fold(child, childPath(path, child.Value), collapsed)
+		}
+	}
+}
+
+// addNode adds the node to the graph, with an edge from its parent if it has
+// one, and returns its ID.
+func (b *builder) addNode(node Node, parent string) string {
+	node.ID = fmt.Sprintf("n%d", len(b.graph.Nodes))
+	b.graph.Nodes = append(b.graph.Nodes, node)
+	if len(parent) > 0 {
+		b.addEdge(Edge{From: parent, To: node.ID})
+	}
+
+	return node.ID
+}
+
+func (b *builder) addEdge(e Edge) {
+	if e.From == e.To || b.edges[e] {
+		return
+	}
+
+	b.edges[e] = true
+	b.graph.Edges = append(b.graph.Edges, e)
+}
+
+// fold draws the tree and all of its descendants as the node with the given ID.
+func (b *builder) fold(t *site.Tree, path, id string) {
+	b.paths[path] = id
+	for _, child := range t.Children {
+		b.fold(child, childPath(path, child.Value), id)
+	}
+}
+
+// addLinks adds an edge between the nodes of every page and the pages it
+// links to. Links between pages that are drawn as the same node are left out.
+func (b *builder) addLinks() {
+	paths := make([]string, 0, len(b.pages))
+	for path := range b.pages {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		from, found := b.paths[treePath(path)]
+		if !found {
+			continue
+		}
+
+		for _, link := range b.pages[path].Links {
+			u, err := url.Parse(link)
+			if err != nil {
+				continue
+			}
+
+			if to, found := b.paths[treePath(u.Path)]; found {
+				b.addEdge(Edge{From: from, To: to, Link: true})
+			}
+		}
+	}
+}
+
+// page returns the page fetched for the path in the tree. The tree doesn't
+// keep trailing slashes so the path is tried with and without one.
+func (b *builder) page(path string) *site.Page {
+	if path == "/" {
+		if page, found := b.pages[""]; found {
+			return page
+		}
+		return b.pages["/"]
+	}
+
+	if page, found := b.pages[path]; found {
+		return page
+	}
+	return b.pages[path+"/"]
+}
+
+func childPath(parent, name string) string {
+	return strings.TrimSuffix(parent, "/") + "/" + name
+}
+
+// treePath converts the path of a URL to the path of its node in the tree.
+func treePath(path string) string {
+	return "/" + strings.Trim(path, "/")
+}
+
+// StatusColor returns the color used for pages with the HTTP status.
+func StatusColor(status int) string {
+	switch {
+	case status >= 500:
+		return "#ef9a9a"
+	case status >= 400:
+		return "#ffcc80"
+	case status >= 300:
+		return "#90caf9"
+	case status >= 200:
+		return "#a5d6a7"
+	default:
+		// The request failed before there was a response.
+		return "#bdbdbd"
+	}
+}
@@ -0,0 +1,131 @@
+package graph
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLKeys are the attributes of the nodes and edges. Gephi reads the r, g
+// and b attributes as the color of a node.
+var graphMLKeys = []graphMLKey{
+	{ID: "label", For: "node", Name: "label", Type: "string"},
+	{ID: "url", For: "node", Name: "url", Type: "string"},
+	{ID: "status", For: "node", Name: "status", Type: "int"},
+	{ID: "collapsed", For: "node", Name: "collapsed", Type: "int"},
+	{ID: "r", For: "node", Name: "r", Type: "int"},
+	{ID: "g", For: "node", Name: "g", Type: "int"},
+	{ID: "b", For: "node", Name: "b", Type: "int"},
+	{ID: "type", For: "edge", Name: "type", Type: "string"},
+}
+
+// WriteGraphML writes the graph as GraphML. Each edge has a type of either
+// "tree" or "link".
+func WriteGraphML(w io.Writer, g *Graph) error {
+	doc := graphML{
+		Xmlns: graphMLNamespace,
+		Keys:  graphMLKeys,
+		Graph: graphMLGraph{ID: "site", EdgeDefault: "directed"},
+	}
+
+	for _, n := range g.Nodes {
+		node := graphMLNode{ID: n.ID, Data: []graphMLData{{Key: "label", Value: n.Label}}}
+		if len(n.URL) > 0 {
+			node.Data = append(node.Data,
+				graphMLData{Key: "url", Value: n.URL},
+				graphMLData{Key: "status", Value: strconv.Itoa(n.Status)},
+			)
+		}
+		if n.Collapsed > 0 {
+			node.Data = append(node.Data, graphMLData{Key: "collapsed", Value: strconv.Itoa(n.Collapsed)})
+		}
+		if r, g, b, ok := parseColor(n.Color); ok {
+			node.Data = append(node.Data,
+				graphMLData{Key: "r", Value: strconv.Itoa(r)},
+				graphMLData{Key: "g", Value: strconv.Itoa(g)},
+				graphMLData{Key: "b", Value: strconv.Itoa(b)},
+			)
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node)
+	}
+
+	for i, e := range g.Edges {
+		edgeType := "tree"
+		if e.Link {
+			edgeType = "link"
+		}
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     "e" + strconv.Itoa(i),
+			Source: e.From,
+			Target: e.To,
+			Data:   []graphMLData{{Key: "type", Value: edgeType}},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// parseColor splits a hex RGB color like #a5d6a7 into its components.
+func parseColor(color string) (r, g, b int, ok bool) {
+	if len(color) != 7 || color[0] != '#' {
+		return 0, 0, 0, false
+	}
+
+	rgb, err := strconv.ParseUint(color[1:], 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return int(rgb >> 16), int(rgb >> 8 & 0xff), int(rgb & 0xff), true
+}
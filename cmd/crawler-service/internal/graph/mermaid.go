@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMermaid writes the graph as a Mermaid flowchart. Links between pages are
+// drawn as dotted edges, and collapsed nodes as stadium shapes.
+func WriteMermaid(w io.Writer, g *Graph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "flowchart LR")
+
+	for _, n := range g.Nodes {
+		if n.Collapsed > 0 {
+			fmt.Fprintf(bw, "  %s([%s])\n", n.ID, mermaidQuote(n.Label))
+			continue
+		}
+		fmt.Fprintf(bw, "  %s[%s]\n", n.ID, mermaidQuote(n.Label))
+	}
+
+	for _, e := range g.Edges {
+		if e.Link {
+			fmt.Fprintf(bw, "  %s -.-> %s\n", e.From, e.To)
+			continue
+		}
+		fmt.Fprintf(bw, "  %s --> %s\n", e.From, e.To)
+	}
+
+	for _, n := range g.Nodes {
+		if len(n.Color) > 0 {
+			fmt.Fprintf(bw, "  style %s fill:%s\n", n.ID, n.Color)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// mermaidQuote returns s as a double quoted Mermaid label. Mermaid doesn't
+// support escaping with backslashes, so characters that would end the label
+// are written as entity codes.
+func mermaidQuote(s string) string {
+	s = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", " ").Replace(s)
+	return `"` + s + `"`
+}
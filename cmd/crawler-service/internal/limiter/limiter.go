@@ -0,0 +1,167 @@
+package limiter
+
+import (
+	"context"
+	"sync"
+)
+
+// New creates a Limiter that allows at most capacity slots to be held at once.
+// A capacity <= 0 doesn't limit the number of slots.
+func New(capacity int) *Limiter {
+	return &Limiter{capacity: capacity}
+}
+
+// Limiter limits the number of slots held across every Queue, and hands out
+// free slots fairly between the queues that are waiting for one. Queues with a
+// higher priority are given proportionally more slots.
+//
+// Fairness uses stride scheduling: every queue has a pass that increases by
+// 1/priority each time it is given a slot, and the waiting queue with the
+// lowest pass gets the next free slot.
+type Limiter struct {
+	mu       sync.Mutex
+	capacity int
+	inUse    int
+
+	// queues are the open queues.
+	queues map[*Queue]struct{}
+
+	// pass is the pass of the queue that was last given a slot. New queues start
+	// here so that they can't starve the queues that are already running.
+	pass float64
+}
+
+// Queue is a single user's share of a Limiter.
+type Queue struct {
+	limiter *Limiter
+	stride  float64
+	pass    float64
+
+	// waiters are closed, in order, when they are given a slot.
+	waiters []chan struct{}
+}
+
+// Queue creates a new queue that waits for slots with the given priority. A
+// priority < 1 is treated as 1. Call Close once the queue is no longer needed.
+func (l *Limiter) Queue(priority int) *Queue {
+	if priority < 1 {
+		priority = 1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.queues == nil {
+		l.queues = map[*Queue]struct{}{}
+	}
+
+	q := &Queue{limiter: l, stride: 1 / float64(priority), pass: l.pass}
+	l.queues[q] = struct{}{}
+	return q
+}
+
+// Acquire blocks until the queue is given a slot or the context is done. Every
+// successful call must be followed by a call to Release.
+func (q *Queue) Acquire(ctx context.Context) error {
+	l := q.limiter
+	l.mu.Lock()
+	if l.capacity <= 0 || (l.inUse < l.capacity && !l.waiting()) {
+		l.grant(q)
+		l.mu.Unlock()
+		return nil
+	}
+
+	// A queue that has been idle shouldn't be able to use the time it was idle
+	// to take every slot once it starts waiting again.
+	if len(q.waiters) == 0 && q.pass < l.pass {
+		q.pass = l.pass
+	}
+
+	ready := make(chan struct{})
+	q.waiters = append(q.waiters, ready)
+	l.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		for i, w := range q.waiters {
+			if w == ready {
+				q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+				return ctx.Err()
+			}
+		}
+
+		// We were given a slot while the context was being cancelled, so pass it
+		// on to someone else.
+		l.inUse--
+		l.dispatch()
+		return ctx.Err()
+	}
+}
+
+// Release returns a slot acquired by Acquire.
+func (q *Queue) Release() {
+	l := q.limiter
+	l.mu.Lock()
+	l.inUse--
+	l.dispatch()
+	l.mu.Unlock()
+}
+
+// Close removes the queue from the limiter. Waiting calls to Acquire are not
+// woken, so they must be cancelled through their contexts.
+func (q *Queue) Close() {
+	l := q.limiter
+	l.mu.Lock()
+	delete(l.queues, q)
+	q.waiters = nil
+	l.mu.Unlock()
+}
+
+// grant gives q a slot. The caller must hold the lock.
+func (l *Limiter) grant(q *Queue) {
+	l.inUse++
+	q.pass += q.stride
+	l.pass = q.pass
+}
+
+// waiting reports whether any queue is waiting for a slot. The caller must hold
+// the lock.
+func (l *Limiter) waiting() bool {
+	for q := range l.queues {
+		if len(q.waiters) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dispatch hands out free slots to the waiting queue with the lowest pass. The
+// caller must hold the lock.
+func (l *Limiter) dispatch() {
+	for l.capacity <= 0 || l.inUse < l.capacity {
+		var next *Queue
+		for q := range l.queues {
+			if len(q.waiters) == 0 {
+				continue
+			}
+
+			if next == nil || q.pass < next.pass {
+				next = q
+			}
+		}
+
+		if next == nil {
+			return
+		}
+
+		l.grant(next)
+		close(next.waiters[0])
+		next.waiters = next.waiters[1:]
+	}
+}
@@ -0,0 +1,49 @@
+package mirror
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// cssURL matches the url() references and @import strings in a stylesheet.
+// The URL is in whichever of the groups matched.
+var cssURL = regexp.MustCompile(`url\(\s*(?:"([^"]*)"|'([^']*)'|([^)"'\s]*))\s*\)|@import\s+(?:"([^"]*)"|'([^']*)')`)
+
+// rewriteCSS rewrites the same-site references in the stylesheet at u to the
+// relative paths of their local copies, and returns the rewritten stylesheet
+// along with the references.
+func (m *Mirror) rewriteCSS(u *url.URL, local string, body []byte) ([]byte, []*url.URL) {
+	var assets []*url.URL
+	seen := map[string]bool{}
+
+	rewritten := cssURL.ReplaceAllFunc(body, func(match []byte) []byte {
+		groups := cssURL.FindSubmatch(match)
+
+		var raw string
+		for _, g := range groups[1:] {
+			if len(g) > 0 {
+				raw = string(g)
+				break
+			}
+		}
+
+		ref, err := u.Parse(strings.TrimSpace(raw))
+		if len(raw) == 0 || err != nil || !m.inScope(ref) {
+			return match
+		}
+
+		if !seen[ref.String()] {
+			seen[ref.String()] = true
+			assets = append(assets, ref)
+		}
+
+		rel := relative(local, LocalPath(ref))
+		if strings.HasPrefix(string(match), "@import") {
+			return []byte(`@import "` + rel + `"`)
+		}
+		return []byte(`url("` + rel + `")`)
+	})
+
+	return rewritten, assets
+}
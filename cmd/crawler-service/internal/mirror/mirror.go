@@ -0,0 +1,182 @@
+// Package mirror saves local copies of the pages and assets of a site, with
+// their links rewritten so that the copy can be browsed offline.
+package mirror
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/document"
+)
+
+var (
+	// ErrFileTooLarge is returned for files that are larger than the
+	// mirror's per-file limit. They aren't saved.
+	ErrFileTooLarge = errors.New("file is larger than the mirror's file size limit")
+
+	// ErrFull is returned for the first file that would make the mirror larger
+	// than its total size limit. It, and every file after it, isn't saved.
+	ErrFull = errors.New("mirror has reached its total size limit")
+)
+
+// Mirror saves the pages and assets of a single site to a directory that
+// mirrors the site's structure.
+//
+// Pages are saved as index.html in a directory named after their path, unless
+// their path already has an extension. Same-site links and assets are
+// rewritten to the relative paths of their local copies, whether or not they
+// have been saved.
+type Mirror struct {
+	dir  string
+	host string
+
+	// maxTotal and maxFile are the limits on the size of the mirror and each
+	// file in it. Values <= 0 don't limit the size.
+	maxTotal int64
+	maxFile  int64
+
+	lock  sync.Mutex
+	total int64
+	files int
+	full  bool
+}
+
+// New returns a mirror that saves the pages on root's host to dir.
+func New(dir string, root *url.URL, maxTotal, maxFile int64) *Mirror {
+	return &Mirror{dir: dir, host: root.Host, maxTotal: maxTotal, maxFile: maxFile}
+}
+
+// Stats returns the number of files that have been saved and their total size.
+func (m *Mirror) Stats() (files int, size int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.files, m.total
+}
+
+// SavePage rewrites the same-site links and assets in the page to their local
+// copies and saves it. It returns the same-site assets that the page uses, so
+// that they can be saved too.
+func (m *Mirror) SavePage(u *url.URL, doc *document.Document) ([]*url.URL, error) {
+	var assets []*url.URL
+	seen := map[string]bool{}
+	for _, a := range doc.Assets() {
+		if m.inScope(a.URL) && !seen[a.URL.String()] {
+			seen[a.URL.String()] = true
+			assets = append(assets, a.URL)
+		}
+	}
+
+	local := LocalPath(u)
+	doc.RewriteURLs(func(link *url.URL) string {
+		if !m.inScope(link) {
+			return ""
+		}
+
+		rel := relative(local, LocalPath(link))
+		if len(link.Fragment) > 0 {
+			rel += "#" + link.Fragment
+		}
+		return rel
+	})
+
+	var buf bytes.Buffer
+	if err := doc.Render(&buf); err != nil {
+		return assets, errors.Wrapf(err, "failed to render %s", u)
+	}
+
+	return assets, m.save(local, buf.Bytes())
+}
+
+// SaveAsset saves a resource that isn't an HTML page. The url() references in
+// stylesheets are rewritten to their local copies, and the same-site ones are
+// returned so that they can be saved too.
+func (m *Mirror) SaveAsset(u *url.URL, contentType string, body []byte) ([]*url.URL, error) {
+	local := LocalPath(u)
+
+	var assets []*url.URL
+	if strings.Contains(contentType, "text/css") {
+		body, assets = m.rewriteCSS(u, local, body)
+	}
+
+	return assets, m.save(local, body)
+}
+
+// inScope returns true if the URL is on the mirrored site.
+func (m *Mirror) inScope(u *url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host == m.host
+}
+
+// save writes the file to the mirror if it fits within the limits.
+func (m *Mirror) save(local string, content []byte) error {
+	size := int64(len(content))
+	if m.maxFile > 0 && size > m.maxFile {
+		return errors.Wrap(ErrFileTooLarge, local)
+	}
+
+	m.lock.Lock()
+	if m.full {
+		m.lock.Unlock()
+		return nil
+	}
+	if m.maxTotal > 0 && m.total+size > m.maxTotal {
+		m.full = true
+		m.lock.Unlock()
+		return errors.Wrap(ErrFull, local)
+	}
+	m.total += size
+	m.files++
+	m.lock.Unlock()
+
+	file := filepath.Join(m.dir, filepath.FromSlash(local))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return errors.Wrapf(err, "failed to save %s", local)
+	}
+
+	return errors.Wrapf(ioutil.WriteFile(file, content, 0644), "failed to save %s", local)
+}
+
+// LocalPath returns the slash separated path, relative to the mirror's
+// directory, that the URL is saved at. Paths without an extension are treated
+// as directories and saved as their index.html. URLs with a query are saved
+// with a hash of the query in their name, so that they don't overwrite each
+// other.
+func LocalPath(u *url.URL) string {
+	p := u.Path
+	if strings.HasSuffix(p, "/") || len(p) == 0 {
+		p += "index.html"
+	} else if !strings.Contains(path.Base(p), ".") {
+		p += "/index.html"
+	}
+
+	// Cleaning a rooted path removes any .. elements, so the file can't be
+	// written outside of the mirror.
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+
+	if len(u.RawQuery) > 0 {
+		sum := sha1.Sum([]byte(u.RawQuery))
+		ext := path.Ext(p)
+		p = strings.TrimSuffix(p, ext) + "-" + hex.EncodeToString(sum[:4]) + ext
+	}
+
+	return p
+}
+
+// relative returns the path of to relative to the directory of from.
+func relative(from, to string) string {
+	rel, err := filepath.Rel(filepath.FromSlash(path.Dir(from)), filepath.FromSlash(to))
+	if err != nil {
+		return to
+	}
+
+	return filepath.ToSlash(rel)
+}
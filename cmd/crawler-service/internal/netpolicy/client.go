@@ -0,0 +1,79 @@
+package netpolicy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// maxRedirects is the number of redirects followed before giving up. It
+// matches the limit used by http.DefaultClient.
+const maxRedirects = 10
+
+// Client returns an http.Client that enforces the policy on every connection
+// it makes. Hostnames are checked before each request, including requests made
+// while following redirects, and every address is checked as it is dialed so
+// that a hostname that resolves to an allowed address during CheckHost can't
+// later be rebound to a denied one.
+func (p *Policy) Client() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   p.control,
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			// Don't use a proxy from the environment, otherwise we would only ever
+			// check the proxy's address.
+			Proxy:                 nil,
+			DialContext:           p.dialContext(dialer),
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.New("stopped after 10 redirects")
+			}
+			return p.checkHostname(req.URL.Hostname())
+		},
+	}
+}
+
+// dialContext checks the hostname before dialing the address with the dialer.
+func (p *Policy) dialContext(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.checkHostname(host); err != nil {
+			return nil, err
+		}
+
+		return dialer.DialContext(ctx, network, addr)
+	}
+}
+
+// control is called with the resolved address of every connection just before
+// it is made.
+func (p *Policy) control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return &DeniedError{Host: host, Reason: "the address could not be parsed"}
+	}
+
+	return p.CheckIP(ip)
+}
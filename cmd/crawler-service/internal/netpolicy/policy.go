@@ -0,0 +1,211 @@
+package netpolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// reservedNets are the loopback, private, link-local and otherwise special
+// purpose networks that the crawler must not connect to unless they are
+// explicitly allowed.
+var reservedNets = mustParseCIDRs(
+	"0.0.0.0/8",      // "This" network
+	"10.0.0.0/8",     // Private
+	"100.64.0.0/10",  // Carrier-grade NAT
+	"127.0.0.0/8",    // Loopback
+	"169.254.0.0/16", // Link-local, including cloud metadata services
+	"172.16.0.0/12",  // Private
+	"192.0.0.0/24",   // IETF protocol assignments
+	"192.168.0.0/16", // Private
+	"198.18.0.0/15",  // Benchmarking
+	"224.0.0.0/4",    // Multicast
+	"240.0.0.0/4",    // Reserved, including broadcast
+	"::/128",         // Unspecified
+	"::1/128",        // Loopback
+	"64:ff9b::/96",   // NAT64, which embeds IPv4 addresses
+	"100::/64",       // Discard
+	"2001::/32",      // Teredo, which embeds IPv4 addresses
+	"2002::/16",      // 6to4, which embeds IPv4 addresses
+	"fc00::/7",       // Unique local
+	"fe80::/10",      // Link-local
+	"ff00::/8",       // Multicast
+)
+
+// DeniedError is returned when the policy doesn't allow a connection to a host
+// or address.
+type DeniedError struct {
+	Host   string
+	Reason string
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("connections to %s are not allowed: %s", e.Host, e.Reason)
+}
+
+// Policy decides which hosts and addresses the crawler may connect to. The zero
+// value denies the reserved networks and allows everything else.
+type Policy struct {
+	// AllowPrivate allows connections to loopback, private, link-local and other
+	// reserved networks.
+	AllowPrivate bool
+
+	// AllowedNets are allowed even if they are reserved networks, so that
+	// specific internal networks can be crawled.
+	AllowedNets []*net.IPNet
+
+	// DeniedNets are never allowed.
+	DeniedNets []*net.IPNet
+
+	// AllowedHosts restricts the crawler to the matching hosts when it is not
+	// empty. See MatchHost for the supported patterns.
+	AllowedHosts []string
+
+	// DeniedHosts are never allowed. See MatchHost for the supported patterns.
+	DeniedHosts []string
+
+	// Resolver is used to look up hosts. net.DefaultResolver is used when it is
+	// nil.
+	Resolver *net.Resolver
+}
+
+// CheckHost returns a DeniedError if the host isn't allowed by name, or if any
+// of the addresses it resolves to are not allowed.
+func (p *Policy) CheckHost(ctx context.Context, host string) error {
+	if err := p.checkHostname(host); err != nil {
+		return err
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return p.CheckIP(ip)
+	}
+
+	resolver := p.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	addrs, err := resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if err := p.CheckIP(addr.IP); err != nil {
+			return &DeniedError{Host: host, Reason: err.(*DeniedError).Reason}
+		}
+	}
+
+	return nil
+}
+
+// CheckIP returns a DeniedError if the policy doesn't allow connections to the
+// address.
+func (p *Policy) CheckIP(ip net.IP) error {
+	if containsIP(p.DeniedNets, ip) {
+		return &DeniedError{Host: ip.String(), Reason: "the address is denied"}
+	}
+
+	if p.AllowPrivate || containsIP(p.AllowedNets, ip) {
+		return nil
+	}
+
+	if containsIP(reservedNets, ip) {
+		return &DeniedError{Host: ip.String(), Reason: "the address is in a private or reserved network"}
+	}
+
+	return nil
+}
+
+// checkHostname applies the allowed and denied host lists to the host.
+func (p *Policy) checkHostname(host string) error {
+	for _, pattern := range p.DeniedHosts {
+		if MatchHost(pattern, host) {
+			return &DeniedError{Host: host, Reason: "the host is denied"}
+		}
+	}
+
+	if len(p.AllowedHosts) == 0 {
+		return nil
+	}
+
+	for _, pattern := range p.AllowedHosts {
+		if MatchHost(pattern, host) {
+			return nil
+		}
+	}
+
+	return &DeniedError{Host: host, Reason: "the host is not in the allowed hosts"}
+}
+
+// MatchHost reports whether the host matches the pattern. A pattern is either
+// an exact hostname, "*.example.com" which matches example.com and all of its
+// subdomains, or "*" which matches any host. Case and trailing dots are
+// ignored.
+func MatchHost(pattern, host string) bool {
+	pattern = strings.ToLower(strings.TrimSuffix(pattern, "."))
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if pattern == "*" {
+		return true
+	}
+	if strings.HasPrefix(pattern, "*.") {
+		return host == pattern[2:] || strings.HasSuffix(host, pattern[1:])
+	}
+
+	return host == pattern
+}
+
+// ParseCIDRs parses a list of CIDRs. A bare address is treated as a network
+// containing only that address.
+func ParseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid address %q", cidr)
+			}
+
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		nets = append(nets, n)
+	}
+
+	return nets, nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets, err := ParseCIDRs(cidrs)
+	if err != nil {
+		panic(err)
+	}
+	return nets
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	// Check IPv4-mapped IPv6 addresses as IPv4 addresses so that ::ffff:127.0.0.1
+	// can't be used to get around the IPv4 rules.
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
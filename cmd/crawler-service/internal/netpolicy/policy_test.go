@@ -0,0 +1,181 @@
+package netpolicy
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestCheckIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		policy Policy
+		denied bool
+	}{
+		// Public addresses are allowed.
+		{ip: "93.184.216.34", denied: false},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", denied: false},
+
+		// Reserved IPv4 networks are denied.
+		{ip: "0.0.0.0", denied: true},
+		{ip: "10.1.2.3", denied: true},
+		{ip: "100.64.0.1", denied: true},
+		{ip: "127.0.0.1", denied: true},
+		{ip: "127.255.255.254", denied: true},
+		{ip: "169.254.169.254", denied: true},
+		{ip: "172.16.0.1", denied: true},
+		{ip: "172.31.255.255", denied: true},
+		{ip: "192.0.0.8", denied: true},
+		{ip: "192.168.1.1", denied: true},
+		{ip: "198.18.0.1", denied: true},
+		{ip: "224.0.0.1", denied: true},
+		{ip: "255.255.255.255", denied: true},
+
+		// The edges of the reserved IPv4 networks.
+		{ip: "172.15.255.255", denied: false},
+		{ip: "172.32.0.0", denied: false},
+		{ip: "100.63.255.255", denied: false},
+		{ip: "100.128.0.0", denied: false},
+
+		// Reserved IPv6 networks are denied, including those that embed IPv4
+		// addresses.
+		{ip: "::", denied: true},
+		{ip: "::1", denied: true},
+		{ip: "::ffff:127.0.0.1", denied: true},
+		{ip: "::ffff:10.0.0.1", denied: true},
+		{ip: "64:ff9b::7f00:1", denied: true},
+		{ip: "100::1", denied: true},
+		{ip: "2001::1", denied: true},
+		{ip: "2002:7f00:1::", denied: true},
+		{ip: "fc00::1", denied: true},
+		{ip: "fd12:3456::1", denied: true},
+		{ip: "fe80::1", denied: true},
+		{ip: "ff02::1", denied: true},
+
+		// Allowing private networks allows all of them.
+		{ip: "127.0.0.1", policy: Policy{AllowPrivate: true}, denied: false},
+		{ip: "fd12:3456::1", policy: Policy{AllowPrivate: true}, denied: false},
+
+		// Allowed networks are allowed even if they are reserved.
+		{ip: "10.1.2.3", policy: Policy{AllowedNets: mustParseCIDRs("10.1.0.0/16")}, denied: false},
+		{ip: "10.2.0.1", policy: Policy{AllowedNets: mustParseCIDRs("10.1.0.0/16")}, denied: true},
+
+		// Denied networks are denied even if they are allowed.
+		{ip: "93.184.216.34", policy: Policy{DeniedNets: mustParseCIDRs("93.184.216.0/24")}, denied: true},
+		{ip: "10.1.2.3", policy: Policy{AllowPrivate: true, DeniedNets: mustParseCIDRs("10.1.2.3")}, denied: true},
+		{ip: "::ffff:10.1.2.3", policy: Policy{AllowPrivate: true, DeniedNets: mustParseCIDRs("10.1.2.3")}, denied: true},
+	}
+
+	for _, test := range tests {
+		ip := net.ParseIP(test.ip)
+		if ip == nil {
+			t.Fatalf("invalid test address %q", test.ip)
+		}
+
+		err := test.policy.CheckIP(ip)
+		if denied := err != nil; denied != test.denied {
+			t.Errorf("CheckIP(%s) with %+v = %v, want denied %v", test.ip, test.policy, err, test.denied)
+		}
+		if _, ok := err.(*DeniedError); err != nil && !ok {
+			t.Errorf("CheckIP(%s) returned a %T, want a *DeniedError", test.ip, err)
+		}
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	// None of these hosts are looked up, since they are either IP addresses or
+	// are denied by name.
+	tests := []struct {
+		host   string
+		policy Policy
+		denied bool
+	}{
+		{host: "93.184.216.34", denied: false},
+		{host: "127.0.0.1", denied: true},
+		{host: "::1", denied: true},
+		{host: "www.example.com", policy: Policy{DeniedHosts: []string{"*.example.com"}}, denied: true},
+		{host: "example.com", policy: Policy{DeniedHosts: []string{"*.example.com"}}, denied: true},
+		{host: "example.org", policy: Policy{AllowedHosts: []string{"example.com"}}, denied: true},
+		{host: "93.184.216.34", policy: Policy{AllowedHosts: []string{"example.com"}}, denied: true},
+		{host: "93.184.216.34", policy: Policy{AllowedHosts: []string{"93.184.216.34"}}, denied: false},
+		{host: "127.0.0.1", policy: Policy{AllowedHosts: []string{"127.0.0.1"}}, denied: true},
+	}
+
+	for _, test := range tests {
+		err := test.policy.CheckHost(context.Background(), test.host)
+		if denied := err != nil; denied != test.denied {
+			t.Errorf("CheckHost(%s) with %+v = %v, want denied %v", test.host, test.policy, err, test.denied)
+		}
+	}
+}
+
+func TestMatchHost(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{pattern: "example.com", host: "example.com", want: true},
+		{pattern: "example.com", host: "Example.COM", want: true},
+		{pattern: "example.com.", host: "example.com", want: true},
+		{pattern: "example.com", host: "example.com.", want: true},
+		{pattern: "example.com", host: "www.example.com", want: false},
+		{pattern: "*.example.com", host: "www.example.com", want: true},
+		{pattern: "*.example.com", host: "a.b.example.com", want: true},
+		{pattern: "*.example.com", host: "example.com", want: true},
+		{pattern: "*.example.com", host: "badexample.com", want: false},
+		{pattern: "*.example.com", host: "example.com.evil.org", want: false},
+		{pattern: "*", host: "example.org", want: true},
+		{pattern: "", host: "example.com", want: false},
+	}
+
+	for _, test := range tests {
+		if got := MatchHost(test.pattern, test.host); got != test.want {
+			t.Errorf("MatchHost(%q, %q) = %v, want %v", test.pattern, test.host, got, test.want)
+		}
+	}
+}
+
+func TestParseCIDRs(t *testing.T) {
+	tests := []struct {
+		cidrs   []string
+		want    []string
+		wantErr bool
+	}{
+		{cidrs: nil, want: []string{}},
+		{cidrs: []string{"10.0.0.0/8"}, want: []string{"10.0.0.0/8"}},
+		{cidrs: []string{"10.1.2.3/8"}, want: []string{"10.0.0.0/8"}},
+		{cidrs: []string{"fd00::/8"}, want: []string{"fd00::/8"}},
+		{cidrs: []string{"10.1.2.3"}, want: []string{"10.1.2.3/32"}},
+		{cidrs: []string{"::1"}, want: []string{"::1/128"}},
+		{cidrs: []string{"10.0.0.0/8", "192.168.1.1"}, want: []string{"10.0.0.0/8", "192.168.1.1/32"}},
+		{cidrs: []string{"10.0.0.0/33"}, wantErr: true},
+		{cidrs: []string{"10.0.0/8"}, wantErr: true},
+		{cidrs: []string{"example.com"}, wantErr: true},
+		{cidrs: []string{""}, wantErr: true},
+		{cidrs: []string{"10.0.0.0/8", "nope"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		nets, err := ParseCIDRs(test.cidrs)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseCIDRs(%q) = %v, want an error", test.cidrs, nets)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseCIDRs(%q) returned an error: %v", test.cidrs, err)
+			continue
+		}
+
+		got := make([]string, 0, len(nets))
+		for _, n := range nets {
+			got = append(got, n.String())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseCIDRs(%q) = %q, want %q", test.cidrs, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Spec decides when a schedule runs.
+type Spec interface {
+	// Next returns the first time after t that the schedule should run.
+	Next(t time.Time) time.Time
+}
+
+// Every returns a Spec that runs every d.
+func Every(d time.Duration) Spec {
+	return interval(d)
+}
+
+type interval time.Duration
+
+func (i interval) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(i))
+}
+
+// cron is a Spec parsed from a cron expression. Each field is a bit set of the
+// values that match.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are true when the day of month or day of week fields
+	// were "*". Like cron, if both fields are restricted then a day matches if
+	// either field matches.
+	domStar, dowStar bool
+}
+
+// field describes the allowed range and names for a cron field.
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// shorthands are the supported @ expressions and the expressions they expand
+// to.
+var shorthands = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a standard five field cron expression (minute, hour, day of
+// month, month and day of week). Fields may be "*", a value, a range "1-5", a
+// step "*/15" or "1-30/2", or a comma separated list of any of these. Months
+// and days of the week may also be given by their three letter names. The
+// @yearly, @monthly, @weekly, @daily and @hourly shorthands are supported.
+func ParseCron(expr string) (Spec, error) {
+	expr = strings.TrimSpace(expr)
+	if expanded, ok := shorthands[strings.ToLower(expr)]; ok {
+		expr = expanded
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron expression %q, found %d", expr, len(fields))
+	}
+
+	var (
+		c   cron
+		err error
+	)
+	if c.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+
+	// Fold Sunday as 7 onto Sunday as 0 so that it matches time.Weekday.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+
+	c.domStar = fields[2] == "*"
+	c.dowStar = fields[4] == "*"
+
+	return &c, nil
+}
+
+// parseField parses a single cron field into a bit set.
+func parseField(expr string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangeExpr = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, part)
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case rangeExpr == "*":
+			lo, hi = f.min, f.max
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+			var err error
+			if lo, err = parseValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rangeExpr, f); err != nil {
+				return 0, err
+			}
+
+			// A single value with a step, like 5/15, runs from the value to the
+			// end of the range.
+			hi = lo
+			if strings.Contains(part, "/") {
+				hi = f.max
+			}
+		}
+
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range in %s field %q", f.name, part)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseValue parses a single number or name in a cron field.
+func parseValue(s string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q, expected a value from %d to %d", f.name, s, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// Next implements Spec.
+func (c *cron) Next(t time.Time) time.Time {
+	// Start at the next whole minute.
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Give up if nothing matches within five years, which is only possible
+	// for expressions like February 30th.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches reports whether the day of month and day of week fields match t.
+func (c *cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
@@ -0,0 +1,265 @@
+// Package search builds an inverted index of the visible text of crawled pages
+// so that they can be searched for words and phrases.
+package search
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+)
+
+// snippetWords is the number of words shown either side of a match in a
+// snippet.
+const snippetWords = 12
+
+// Index is an inverted index of the text of a crawl's pages. It is safe for
+// concurrent searches once it has been built.
+type Index struct {
+	// pages are the indexed pages, sorted by their URL. Their text isn't
+	// copied, and is only tokenized again to build the snippets of matches.
+	pages []*site.Page
+
+	// postings are the pages each term appears in, in the order of the pages.
+	postings map[string][]posting
+}
+
+// posting is the positions of a term in a page, as indexes of the page's
+// terms.
+type posting struct {
+	page      int
+	positions []int
+}
+
+// Match is a page that matched a query.
+type Match struct {
+	URL   string
+	Title string
+
+	// Snippet is the text around the first appearance of the query's words
+	// or phrases in the page.
+	Snippet string
+
+	// Score is the number of times the query's words and phrases appear in
+	// the page.
+	Score int
+}
+
+// NewIndex indexes the text of the pages that were fetched successfully.
+func NewIndex(pages map[string]*site.Page) *Index {
+	idx := &Index{postings: map[string][]posting{}}
+
+	for _, p := range pages {
+		if p.Status == http.StatusOK && len(p.Text) > 0 {
+			idx.pages = append(idx.pages, p)
+		}
+	}
+	sort.Slice(idx.pages, func(i, j int) bool { return idx.pages[i].URL < idx.pages[j].URL })
+
+	for i, p := range idx.pages {
+		positions := map[string][]int{}
+		for pos, t := range tokenize(p.Text) {
+			positions[t.term] = append(positions[t.term], pos)
+		}
+		for term, pos := range positions {
+			idx.postings[term] = append(idx.postings[term], posting{page: i, positions: pos})
+		}
+	}
+
+	return idx
+}
+
+// Query is a parsed search query. Each of its phrases is a run of terms that
+// must appear together in a page.
+type Query struct {
+	phrases [][]string
+}
+
+// ParseQuery splits the query into phrases. Words in double quotes form a
+// single phrase and every other word is a phrase of its own. Case and
+// punctuation are ignored.
+func ParseQuery(query string) (Query, error) {
+	var q Query
+	for i, part := range strings.Split(query, `"`) {
+		terms := terms(part)
+		if len(terms) == 0 {
+			continue
+		}
+
+		// The odd parts are the ones inside quotes.
+		if i%2 == 1 {
+			q.phrases = append(q.phrases, terms)
+			continue
+		}
+		for _, term := range terms {
+			q.phrases = append(q.phrases, []string{term})
+		}
+	}
+
+	if len(q.phrases) == 0 {
+		return Query{}, errors.New("the query doesn't have any words")
+	}
+
+	return q, nil
+}
+
+// Search returns the pages that contain every phrase of the query, sorted by
+// their score and then their URL.
+func (idx *Index) Search(q Query) []Match {
+	if idx == nil || len(q.phrases) == 0 {
+		return nil
+	}
+
+	// starts are the positions each phrase starts at in each page, keyed by
+	// the index of the page. Only the pages that matched every phrase so far
+	// are kept.
+	var starts map[int][][]int
+	for i, phrase := range q.phrases {
+		found := idx.phrase(phrase)
+		if i == 0 {
+			starts = map[int][][]int{}
+			for d, pos := range found {
+				starts[d] = [][]int{pos}
+			}
+			continue
+		}
+
+		for d := range starts {
+			pos, ok := found[d]
+			if !ok {
+				delete(starts, d)
+				continue
+			}
+			starts[d] = append(starts[d], pos)
+		}
+	}
+
+	matches := make([]Match, 0, len(starts))
+	for d, phrases := range starts {
+		page := idx.pages[d]
+		m := Match{URL: page.URL, Title: page.Title}
+
+		// The snippet is taken from around the phrase that appears first.
+		first, firstLen := -1, 0
+		for i, pos := range phrases {
+			m.Score += len(pos)
+			if first < 0 || pos[0] < first {
+				first, firstLen = pos[0], len(q.phrases[i])
+			}
+		}
+		m.Snippet = snippet(page.Text, first, first+firstLen-1)
+
+		matches = append(matches, m)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].URL < matches[j].URL
+	})
+
+	return matches
+}
+
+// phrase returns the positions the phrase starts at in each page it appears
+// in, keyed by the index of the page.
+func (idx *Index) phrase(terms []string) map[int][]int {
+	found := map[int][]int{}
+	for _, p := range idx.postings[terms[0]] {
+		for _, pos := range p.positions {
+			matched := true
+			for i, term := range terms[1:] {
+				if !idx.at(term, p.page, pos+i+1) {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				found[p.page] = append(found[p.page], pos)
+			}
+		}
+	}
+
+	return found
+}
+
+// at returns true if the term is at the position in the page.
+func (idx *Index) at(term string, page, pos int) bool {
+	postings := idx.postings[term]
+	i := sort.Search(len(postings), func(i int) bool { return postings[i].page >= page })
+	if i == len(postings) || postings[i].page != page {
+		return false
+	}
+
+	positions := postings[i].positions
+	j := sort.SearchInts(positions, pos)
+	return j < len(positions) && positions[j] == pos
+}
+
+// snippet returns the text from a few words before the first term to a few
+// words after the last, with its whitespace collapsed.
+func snippet(text string, first, last int) string {
+	tokens := tokenize(text)
+	from, to := first-snippetWords, last+snippetWords
+	prefix, suffix := "…", "…"
+	if from <= 0 {
+		from, prefix = 0, ""
+	}
+	if to >= len(tokens)-1 {
+		to, suffix = len(tokens)-1, ""
+	}
+
+	start, end := tokens[from].start, tokens[to].end
+	if from == 0 {
+		start = 0
+	}
+	if to == len(tokens)-1 {
+		end = len(text)
+	}
+
+	return prefix + strings.Join(strings.Fields(text[start:end]), " ") + suffix
+}
+
+// token is a term and where it starts and ends in the text it was found in.
+type token struct {
+	term       string
+	start, end int
+}
+
+// tokenize splits the text into lower cased runs of letters and numbers.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		if start >= 0 {
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+func terms(text string) []string {
+	tokens := tokenize(text)
+	terms := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		terms = append(terms, t.term)
+	}
+
+	return terms
+}
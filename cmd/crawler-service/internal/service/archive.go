@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/warc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newArchive returns the writer that a job's requests and responses are
+// archived with. The job's ID and owner are recorded in the warcinfo record of
+// each file.
+func (s *Service) newArchive(j *job) *warc.Writer {
+	info := warc.Header{
+		{Name: "software", Value: "crawler-service"},
+		{Name: "format", Value: "WARC File Format 1.1"},
+		{Name: "conformsTo", Value: "http://iipc.github.io/warc-specifications/specifications/warc-format/warc-1.1/"},
+		{Name: "job-id", Value: j.id},
+		{Name: "seed-url", Value: j.target.String()},
+	}
+	if len(j.owner) > 0 {
+		info = append(info, warc.Field{Name: "owner", Value: j.owner})
+	}
+
+	return warc.NewWriter(s.archiveDir, "crawl-"+j.id, s.archiveMaxSize, info)
+}
+
+// openReplay opens the WARC files that the crawl with the given ID was archived
+// to, if id isn't empty, and the WARC files at the given paths, so that they
+// can be replayed. The paths are relative to the archive directory and must
+// not lead out of it. The caller must be allowed to read the crawls that the
+// files were archived by, and files that don't record an owner, like those of
+// other archivers, may be read by anyone.
+func (s *Service) openReplay(ctx context.Context, id string, paths []string) (*warc.Archive, error) {
+	if len(s.archiveDir) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "The service isn't configured to archive crawls")
+	}
+
+	var files []string
+	if len(id) > 0 {
+		// The ID is used in a glob pattern so make sure it can't match
+		// another crawl's files or escape the directory.
+		if strings.ContainsAny(id, `/\*?[]`) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is not a valid crawl ID", id)
+		}
+
+		matches, err := filepath.Glob(filepath.Join(s.archiveDir, "crawl-"+id+"-*.warc.gz"))
+		if err != nil || len(matches) == 0 {
+			return nil, status.Errorf(codes.NotFound, "There isn't an archive of crawl %s", id)
+		}
+		files = append(files, matches...)
+	}
+
+	for _, path := range paths {
+		resolved, err := s.archivePath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		matches, err := warc.Files(resolved)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "There isn't a WARC file at %s", path)
+		}
+		files = append(files, matches...)
+	}
+
+	for _, file := range files {
+		info, err := warc.ReadInfo(file)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to open the archive to replay: %v", err)
+		}
+		if owner := info.Get("owner"); len(owner) > 0 {
+			if err := authorizeRead(ctx, owner); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	archive, err := warc.Open(files...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to open the archive to replay: %v", err)
+	}
+
+	return archive, nil
+}
+
+// archivePath resolves a path relative to the archive directory, following
+// any symbolic links, and checks that it is inside the directory.
+func (s *Service) archivePath(path string) (string, error) {
+	if filepath.IsAbs(path) {
+		return "", status.Errorf(codes.InvalidArgument, "%s must be relative to the archive directory", path)
+	}
+
+	dir, err := filepath.EvalSymlinks(s.archiveDir)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "Failed to open the archive directory: %v", err)
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Join(dir, path))
+	if err != nil {
+		return "", status.Errorf(codes.NotFound, "There isn't a WARC file at %s", path)
+	}
+
+	rel, err := filepath.Rel(dir, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", status.Errorf(codes.InvalidArgument, "%s is not in the archive directory", path)
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	pb "github.com/wrrn/crawler/pkg/crawler"
+)
+
+// AssetReport summarizes the assets used by the pages of a crawl: how many
+// pages use each asset, which assets are broken, and the total weight of each
+// page.
+func (s *Service) AssetReport(ctx context.Context, req *pb.AssetReportRequest) (*pb.AssetReportResponse, error) {
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AssetReportResponse{Id: r.id, Checked: r.assetChecks != nil}
+	usage := map[string]*pb.AssetUsage{}
+	for _, page := range r.pages {
+		if !strings.Contains(page.ContentType, "text/html") {
+			continue
+		}
+
+		weight := &pb.PageWeight{Url: page.URL, HtmlSize: page.Size, Assets: int32(len(page.Assets))}
+		for _, a := range page.Assets {
+			u, found := usage[a.URL]
+			if !found {
+				u = &pb.AssetUsage{Url: a.URL, Type: a.Type, ThirdParty: a.ThirdParty, Size: -1}
+				if check, checked := r.assetChecks[a.URL]; checked {
+					u.Status = int32(check.Status)
+					u.Error = check.Error
+					u.ContentType = check.ContentType
+					u.Size = check.Size
+					u.Broken = check.Broken()
+				}
+				usage[a.URL] = u
+			}
+			u.Pages++
+
+			if u.Size >= 0 {
+				weight.AssetSize += u.Size
+			} else {
+				weight.UnknownSizes++
+			}
+		}
+
+		weight.TotalSize = weight.HtmlSize + weight.AssetSize
+		resp.Pages = append(resp.Pages, weight)
+	}
+
+	for _, u := range usage {
+		resp.Assets = append(resp.Assets, u)
+	}
+
+	sort.Slice(resp.Assets, func(i, j int) bool {
+		if resp.Assets[i].GetPages() != resp.Assets[j].GetPages() {
+			return resp.Assets[i].GetPages() > resp.Assets[j].GetPages()
+		}
+		return resp.Assets[i].GetUrl() < resp.Assets[j].GetUrl()
+	})
+
+	sort.Slice(resp.Pages, func(i, j int) bool {
+		if resp.Pages[i].GetTotalSize() != resp.Pages[j].GetTotalSize() {
+			return resp.Pages[i].GetTotalSize() > resp.Pages[j].GetTotalSize()
+		}
+		return resp.Pages[i].GetUrl() < resp.Pages[j].GetUrl()
+	})
+
+	return resp, nil
+}
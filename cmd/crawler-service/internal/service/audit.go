@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/audit"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditIssues maps the audit's issues to their protobuf types, in the order
+// they're reported.
+var auditIssues = []struct {
+	issue audit.Issue
+	typ   pb.AuditIssueType
+}{
+	{audit.MissingTitle, pb.AuditIssueType_MISSING_TITLE},
+	{audit.DuplicateTitle, pb.AuditIssueType_DUPLICATE_TITLE},
+	{audit.MissingDescription, pb.AuditIssueType_MISSING_DESCRIPTION},
+	{audit.DuplicateDescription, pb.AuditIssueType_DUPLICATE_DESCRIPTION},
+	{audit.MissingH1, pb.AuditIssueType_MISSING_H1},
+	{audit.MultipleH1, pb.AuditIssueType_MULTIPLE_H1},
+	{audit.CanonicalElsewhere, pb.AuditIssueType_CANONICAL_ELSEWHERE},
+	{audit.NoIndex, pb.AuditIssueType_NOINDEX},
+	{audit.LongURL, pb.AuditIssueType_LONG_URL},
+	{audit.ThinContent, pb.AuditIssueType_THIN_CONTENT},
+}
+
+// Audit checks the pages of a crawl for common SEO problems and lists the
+// pages with each problem.
+func (s *Service) Audit(ctx context.Context, req *pb.AuditRequest) (*pb.AuditResponse, error) {
+	if req.GetMaxUrlLength() < 0 || req.GetMinWords() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "The audit thresholds can't be negative")
+	}
+
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]*site.Page, 0, len(r.pages))
+	for _, p := range r.pages {
+		pages = append(pages, p)
+	}
+
+	report := audit.Audit(pages, audit.Options{
+		MaxURLLength: int(req.GetMaxUrlLength()),
+		MinWords:     int(req.GetMinWords()),
+	})
+
+	resp := &pb.AuditResponse{Id: r.id, Pages: int32(report.Pages)}
+	for _, i := range auditIssues {
+		findings := report.Findings[i.issue]
+		if len(findings) == 0 {
+			continue
+		}
+
+		issue := &pb.AuditIssue{Type: i.typ}
+		for _, f := range findings {
+			issue.Pages = append(issue.Pages, &pb.AuditFinding{Url: f.URL, Detail: f.Detail})
+		}
+		resp.Issues = append(resp.Issues, issue)
+	}
+
+	return resp, nil
+}
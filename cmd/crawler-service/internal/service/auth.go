@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authorizeCrawl returns a PermissionDenied error if the caller may not crawl
+// the given host. Every host is allowed when authentication is disabled.
+func authorizeCrawl(ctx context.Context, host string) error {
+	id, ok := auth.FromContext(ctx)
+	if !ok || id.CanCrawl(host) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to crawl %s", id.Subject, host)
+}
+
+// authorizeManage returns a PermissionDenied error if the caller may not stop
+// or delete a crawl started by owner. Everything is allowed when
+// authentication is disabled.
+func authorizeManage(ctx context.Context, owner string) error {
+	id, ok := auth.FromContext(ctx)
+	if !ok || id.CanManage(owner) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to manage crawls started by %s", id.Subject, owner)
+}
+
+// authorizeRead returns a PermissionDenied error if the caller may not read the
+// results of a crawl started by owner, or replay what it archived.
+func authorizeRead(ctx context.Context, owner string) error {
+	if canRead(ctx, owner) {
+		return nil
+	}
+
+	id, _ := auth.FromContext(ctx)
+	return status.Errorf(codes.PermissionDenied, "%s is not allowed to read crawls started by %s", id.Subject, owner)
+}
+
+// canRead reports whether the caller may read the results of a crawl started
+// by owner. Everything is allowed when authentication is disabled.
+func canRead(ctx context.Context, owner string) bool {
+	id, ok := auth.FromContext(ctx)
+	return !ok || id.CanRead(owner)
+}
+
+// subject returns the subject of the caller's identity, or an empty string if
+// authentication is disabled.
+func subject(ctx context.Context) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		return id.Subject
+	}
+
+	return ""
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+)
+
+// CanonicalReport reports the pages whose canonical URLs form chains or point
+// to pages that didn't respond with 200 OK, and the hreflang clusters with
+// links that aren't returned.
+func (s *Service) CanonicalReport(ctx context.Context, req *pb.CanonicalReportRequest) (*pb.CanonicalReportResponse, error) {
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]*site.Page, 0, len(r.pages))
+	for _, p := range r.pages {
+		pages = append(pages, p)
+	}
+	byURL := pagesByURL(pages)
+
+	resp := &pb.CanonicalReportResponse{
+		Id:               r.id,
+		HreflangClusters: hreflangClusters(pages, byURL),
+	}
+	for _, p := range pages {
+		if !p.CanonicalElsewhere() {
+			continue
+		}
+
+		if chain := canonicalChain(p, byURL); len(chain.GetUrls()) > 2 {
+			resp.Chains = append(resp.Chains, chain)
+		}
+
+		target, crawled := byURL[site.NormalizeURL(p.Canonical)]
+		if crawled && target.Status == http.StatusOK {
+			continue
+		}
+
+		broken := &pb.BrokenCanonical{Url: p.URL, Canonical: p.Canonical, Crawled: crawled}
+		if crawled {
+			broken.Status = int32(target.Status)
+			broken.Error = target.Error
+		}
+		resp.Broken = append(resp.Broken, broken)
+	}
+
+	sort.Slice(resp.Chains, func(i, j int) bool { return resp.Chains[i].GetUrls()[0] < resp.Chains[j].GetUrls()[0] })
+	sort.Slice(resp.Broken, func(i, j int) bool { return resp.Broken[i].GetUrl() < resp.Broken[j].GetUrl() })
+
+	return resp, nil
+}
+
+// pagesByURL keys the pages by the normalized URL that was requested and the
+// URL they were served from, so that a page is found whichever of its URLs a
+// canonical or hreflang link uses. A page requested at a URL takes precedence
+// over a page that was redirected to it.
+func pagesByURL(pages []*site.Page) map[string]*site.Page {
+	byURL := make(map[string]*site.Page, len(pages))
+	for _, p := range pages {
+		byURL[site.NormalizeURL(p.URL)] = p
+	}
+	for _, p := range pages {
+		if _, found := byURL[p.ResponseURL()]; !found {
+			byURL[p.ResponseURL()] = p
+		}
+	}
+
+	return byURL
+}
+
+// canonicalChain follows the canonical URLs from the page through the crawled
+// pages until it reaches a page that is its own canonical, a page that wasn't
+// crawled, or a URL that it has already visited.
+func canonicalChain(p *site.Page, byURL map[string]*site.Page) *pb.CanonicalChain {
+	chain := &pb.CanonicalChain{Urls: []string{p.URL}}
+	visited := map[string]bool{p.ResponseURL(): true}
+	for p.CanonicalElsewhere() {
+		canonical := site.NormalizeURL(p.Canonical)
+		chain.Urls = append(chain.Urls, p.Canonical)
+		if visited[canonical] {
+			chain.Loop = true
+			break
+		}
+		visited[canonical] = true
+
+		next, crawled := byURL[canonical]
+		if !crawled {
+			break
+		}
+		p = next
+	}
+
+	return chain
+}
+
+// hreflangClusters groups the pages that link to each other through hreflang
+// links, and returns the groups where a crawled page doesn't link back to a
+// page that links to it.
+func hreflangClusters(pages []*site.Page, byURL map[string]*site.Page) []*pb.HreflangCluster {
+	// Join the pages into clusters by giving each URL the parent of the
+	// cluster it belongs to.
+	parents := map[string]string{}
+	var root func(u string) string
+	root = func(u string) string {
+		parent, found := parents[u]
+		if !found || parent == u {
+			parents[u] = u
+			return u
+		}
+
+		parents[u] = root(parent)
+		return parents[u]
+	}
+
+	langs := map[string]map[string]bool{}
+	for _, p := range pages {
+		for _, h := range p.Hreflangs {
+			u := site.NormalizeURL(h.URL)
+			parents[root(u)] = root(site.NormalizeURL(p.URL))
+			if langs[u] == nil {
+				langs[u] = map[string]bool{}
+			}
+			langs[u][h.Lang] = true
+		}
+	}
+
+	clusters := map[string]*pb.HreflangCluster{}
+	for _, p := range pages {
+		for _, h := range p.Hreflangs {
+			target, crawled := byURL[site.NormalizeURL(h.URL)]
+			if target == p || !crawled || linksTo(target, p) {
+				continue
+			}
+
+			id := root(site.NormalizeURL(p.URL))
+			if clusters[id] == nil {
+				clusters[id] = &pb.HreflangCluster{}
+			}
+			clusters[id].Missing = append(clusters[id].Missing, &pb.MissingReturnLink{From: p.URL, To: h.URL, Lang: h.Lang})
+		}
+	}
+
+	for u := range parents {
+		cluster := clusters[root(u)]
+		if cluster == nil {
+			continue
+		}
+
+		page := &pb.HreflangPage{Url: u}
+		_, page.Crawled = byURL[u]
+		for lang := range langs[u] {
+			page.Langs = append(page.Langs, lang)
+		}
+		sort.Strings(page.Langs)
+		cluster.Pages = append(cluster.Pages, page)
+	}
+
+	sorted := make([]*pb.HreflangCluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		sort.Slice(cluster.Pages, func(i, j int) bool { return cluster.Pages[i].GetUrl() < cluster.Pages[j].GetUrl() })
+		sort.Slice(cluster.Missing, func(i, j int) bool {
+			if cluster.Missing[i].GetFrom() != cluster.Missing[j].GetFrom() {
+				return cluster.Missing[i].GetFrom() < cluster.Missing[j].GetFrom()
+			}
+			return cluster.Missing[i].GetTo() < cluster.Missing[j].GetTo()
+		})
+		sorted = append(sorted, cluster)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].GetPages()[0].GetUrl() < sorted[j].GetPages()[0].GetUrl() })
+
+	return sorted
+}
+
+// linksTo returns true if the page has a hreflang link to either the requested
+// or response URL of the target.
+func linksTo(p, target *site.Page) bool {
+	for _, h := range p.Hreflangs {
+		u := site.NormalizeURL(h.URL)
+		if u == site.NormalizeURL(target.URL) || u == target.ResponseURL() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Diff compares two crawls of the same URL and reports the pages that were
+// added, removed and changed.
+func (s *Service) Diff(ctx context.Context, req *pb.DiffRequest) (*pb.DiffResponse, error) {
+	from, to, err := s.getDiffResults(ctx, req.GetUrl(), req.GetFromId(), req.GetToId())
+	if err != nil {
+		return nil, err
+	}
+
+	changes := site.Diff(from.pages, to.pages)
+
+	resp := &pb.DiffResponse{
+		FromId: from.id,
+		ToId:   to.id,
+		Tree:   diffTreeToProto(from.tree.Value, changes),
+	}
+
+	for _, c := range changes {
+		if c.Type == site.Unchanged {
+			continue
+		}
+
+		resp.Changes = append(resp.Changes, &pb.PageChange{
+			Path:   c.Path,
+			Change: pb.ChangeType(c.Type),
+			From:   pageToProto(c.From),
+			To:     pageToProto(c.To),
+			Fields: c.Fields,
+		})
+	}
+
+	return resp, nil
+}
+
+// getDiffResults returns the results with the given IDs for the URL. If the
+// IDs are empty then the two most recent results that the caller may read are
+// returned.
+func (s *Service) getDiffResults(ctx context.Context, url, fromID, toID string) (result, result, error) {
+	s.treesLock.RLock()
+	defer s.treesLock.RUnlock()
+
+	results := s.trees[url]
+	if len(fromID) == 0 && len(toID) == 0 {
+		readable := readableResults(ctx, results)
+		if len(readable) < 2 {
+			return result{}, result{}, status.Errorf(codes.FailedPrecondition, "%s needs to be crawled at least twice to compare crawls", url)
+		}
+
+		return readable[len(readable)-2], readable[len(readable)-1], nil
+	}
+
+	from, foundFrom := findResult(results, fromID)
+	to, foundTo := findResult(results, toID)
+	if !foundFrom || !foundTo {
+		return result{}, result{}, status.Errorf(codes.NotFound, "Both %q and %q need to be crawls of %s", fromID, toID, url)
+	}
+
+	for _, r := range []result{from, to} {
+		if err := authorizeRead(ctx, r.owner); err != nil {
+			return result{}, result{}, err
+		}
+	}
+
+	return from, to, nil
+}
+
+// findResult returns the result with the given ID.
+func findResult(results []result, id string) (result, bool) {
+	for _, r := range results {
+		if r.id == id {
+			return r, true
+		}
+	}
+
+	return result{}, false
+}
+
+// diffTreeToProto builds a site tree out of the paths of every change and
+// marks each node with how it changed. Nodes that weren't fetched themselves
+// take their change from their children: added or removed if all of their
+// children were, otherwise unchanged.
+func diffTreeToProto(root string, changes []site.Change) *pb.DiffTree {
+	tree := site.Tree{Value: root}
+	types := make(map[string]site.ChangeType, len(changes))
+	for _, c := range changes {
+		path := strings.Trim(c.Path, "/")
+		types[path] = c.Type
+		if len(path) > 0 {
+			tree.Add(path)
+		}
+	}
+
+	return diffNodeToProto(&tree, "", types)
+}
+
+func diffNodeToProto(t *site.Tree, path string, types map[string]site.ChangeType) *pb.DiffTree {
+	node := &pb.DiffTree{Name: t.Value}
+	for _, child := range t.Children {
+		childPath := child.Value
+		if len(path) > 0 {
+			childPath = path + "/" + child.Value
+		}
+		node.Children = append(node.Children, diffNodeToProto(child, childPath, types))
+	}
+
+	if changeType, found := types[path]; found {
+		node.Change = pb.ChangeType(changeType)
+		return node
+	}
+
+	if len(node.Children) > 0 {
+		node.Change = node.Children[0].Change
+		for _, child := range node.Children {
+			if child.Change != node.Change || (child.Change != pb.ChangeType_ADDED && child.Change != pb.ChangeType_REMOVED) {
+				node.Change = pb.ChangeType_UNCHANGED
+				break
+			}
+		}
+	}
+
+	return node
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/fingerprint"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxDistance is the largest distance between two SimHashes.
+const maxDistance = 64
+
+// DuplicateReport groups the pages of a crawl whose text is the same, or whose
+// SimHashes are within the requested distance of each other.
+func (s *Service) DuplicateReport(ctx context.Context, req *pb.DuplicateReportRequest) (*pb.DuplicateReportResponse, error) {
+	distance := int(req.GetMaxDistance())
+	if distance < 0 || distance > maxDistance {
+		return nil, status.Errorf(codes.InvalidArgument, "The distance must be between 0 and %d", maxDistance)
+	}
+	if distance == 0 {
+		distance = fingerprint.DefaultMaxDistance
+	}
+
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []*site.Page
+	for _, p := range r.pages {
+		if p.Status == http.StatusOK && p.Words > 0 {
+			pages = append(pages, p)
+		}
+	}
+	sort.Slice(pages, func(i, j int) bool { return pages[i].URL < pages[j].URL })
+
+	// Join each page to the first cluster whose first page it duplicates, by
+	// giving it the index of the cluster's first page. Pages are only compared
+	// with the first page of each cluster, so that every page of a cluster is
+	// within the distance of the page it's reported against, rather than
+	// clusters chaining together through pages that are only close to their
+	// neighbours.
+	cluster := make([]int, len(pages))
+	for i := range pages {
+		cluster[i] = i
+		for j := 0; j < i; j++ {
+			if cluster[j] != j {
+				continue
+			}
+			if pages[i].TextHash == pages[j].TextHash || fingerprint.Distance(pages[i].SimHash, pages[j].SimHash) <= distance {
+				cluster[i] = cluster[j]
+				break
+			}
+		}
+	}
+
+	clusters := map[int]*pb.DuplicateCluster{}
+	for i, p := range pages {
+		first := pages[cluster[i]]
+		c, found := clusters[cluster[i]]
+		if !found {
+			c = &pb.DuplicateCluster{Exact: true}
+			clusters[cluster[i]] = c
+		}
+
+		c.Exact = c.Exact && p.TextHash == first.TextHash
+		c.Pages = append(c.Pages, &pb.DuplicatePage{
+			Url:      p.URL,
+			Distance: int32(fingerprint.Distance(p.SimHash, first.SimHash)),
+			Skipped:  len(p.DuplicateOf) > 0,
+		})
+	}
+
+	resp := &pb.DuplicateReportResponse{Id: r.id, Pages: int32(len(pages))}
+	for _, c := range clusters {
+		if len(c.GetPages()) > 1 {
+			resp.Clusters = append(resp.Clusters, c)
+		}
+	}
+
+	sort.Slice(resp.Clusters, func(i, j int) bool {
+		if len(resp.Clusters[i].GetPages()) != len(resp.Clusters[j].GetPages()) {
+			return len(resp.Clusters[i].GetPages()) > len(resp.Clusters[j].GetPages())
+		}
+		return resp.Clusters[i].GetPages()[0].GetUrl() < resp.Clusters[j].GetPages()[0].GetUrl()
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/graph"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/sitemap"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the most bytes of a file sent in each message of an
+// export, which keeps the messages well below gRPC's default limit of 4MB.
+const exportChunkSize = 1 << 20
+
+// Export converts a crawl into another format, such as a sitemap, a graph or
+// the structured data of its pages, and streams the files in chunks.
+func (s *Service) Export(req *pb.ExportRequest, stream pb.Crawler_ExportServer) error {
+	r, err := s.getResult(stream.Context(), req.GetUrl(), req.GetId())
+	if err != nil {
+		return err
+	}
+
+	var files []*pb.ExportFile
+	switch req.GetFormat() {
+	case pb.ExportFormat_SITEMAP:
+		files, err = exportSitemap(r, req.GetUrl(), req.GetBaseUrl())
+	case pb.ExportFormat_DOT, pb.ExportFormat_GRAPHML, pb.ExportFormat_MERMAID:
+		files, err = exportGraph(r, req.GetUrl(), req.GetFormat(), req.GetGraph())
+	case pb.ExportFormat_JSONL:
+		files, err = exportJSONL(r, req.GetUrl())
+	default:
+		return status.Errorf(codes.InvalidArgument, "Unsupported export format %s", req.GetFormat())
+	}
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to export crawl %s: %v", r.id, err)
+	}
+
+	for _, f := range files {
+		if err := sendFile(stream, r.id, f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendFile sends the file in chunks of at most exportChunkSize bytes. An empty
+// file is sent as a single empty chunk.
+func sendFile(stream pb.Crawler_ExportServer, id string, f *pb.ExportFile) error {
+	content := f.GetContent()
+	for {
+		n := len(content)
+		if n > exportChunkSize {
+			n = exportChunkSize
+		}
+
+		chunk := &pb.ExportFile{Name: f.GetName(), Content: content[:n]}
+		if err := stream.Send(&pb.ExportResponse{Id: id, File: chunk}); err != nil {
+			return err
+		}
+
+		content = content[n:]
+		if len(content) == 0 {
+			return nil
+		}
+	}
+}
+
+// exportSitemap generates a sitemap of the HTML pages that were successfully
+// fetched. The pages are listed by the URL they were served from, without a
+// fragment, and their last modified times come from their Last-Modified
+// header, or when they were fetched if they don't have one. Pages that ask not
+// to be indexed, that have a canonical URL elsewhere, or that were served from
+// outside of the base URL are left out, as search engines reject sitemaps
+// that list them.
+func exportSitemap(r result, rawURL, baseURL string) ([]*pb.ExportFile, error) {
+	if len(baseURL) == 0 {
+		root, err := parseURL(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		baseURL = root.Scheme + "://" + root.Host + "/"
+
+		// If the start page redirected, the sitemap lists the pages of the
+		// host it redirected to.
+		start := site.NormalizeURL(root.String())
+		for _, page := range r.pages {
+			if site.NormalizeURL(page.URL) != start {
+				continue
+			}
+			if u, err := url.Parse(page.ResponseURL()); err == nil {
+				baseURL = u.Scheme + "://" + u.Host + "/"
+			}
+			break
+		}
+	}
+
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	prefix := site.NormalizeURL(baseURL)
+
+	urls := make([]sitemap.URL, 0, len(r.pages))
+	seen := map[string]bool{}
+	for _, page := range r.pages {
+		if page.Status != http.StatusOK || !strings.Contains(page.ContentType, "text/html") {
+			continue
+		}
+		if page.NoIndex || page.CanonicalElsewhere() {
+			continue
+		}
+
+		// Pages that redirected to another page that was crawled are only
+		// listed once, and pages that redirected to another host aren't
+		// listed at all.
+		loc := page.ResponseURL()
+		if seen[loc] || !strings.HasPrefix(loc, prefix) {
+			continue
+		}
+		seen[loc] = true
+
+		lastMod := page.FetchedAt
+		if t, err := http.ParseTime(page.LastModified); err == nil {
+			lastMod = t
+		}
+
+		urls = append(urls, sitemap.URL{Loc: loc, LastMod: lastMod})
+	}
+
+	sort.Slice(urls, func(i, j int) bool { return urls[i].Loc < urls[j].Loc })
+
+	generated, err := sitemap.Generate(urls, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*pb.ExportFile, 0, len(generated))
+	for _, f := range generated {
+		files = append(files, &pb.ExportFile{Name: f.Name, Content: f.Content})
+	}
+
+	return files, nil
+}
+
+// graphWriters write a graph in each of the graph formats, and graphExtensions
+// are the file extensions used for them.
+var (
+	graphWriters = map[pb.ExportFormat]func(io.Writer, *graph.Graph) error{
+		pb.ExportFormat_DOT:     graph.WriteDOT,
+		pb.ExportFormat_GRAPHML: graph.WriteGraphML,
+		pb.ExportFormat_MERMAID: graph.WriteMermaid,
+	}
+	graphExtensions = map[pb.ExportFormat]string{
+		pb.ExportFormat_DOT:     ".dot",
+		pb.ExportFormat_GRAPHML: ".graphml",
+		pb.ExportFormat_MERMAID: ".mmd",
+	}
+)
+
+// exportGraph draws the site tree, and optionally the links between pages, as
+// a graph in a single file named after the site's host.
+func exportGraph(r result, rawURL string, format pb.ExportFormat, opts *pb.GraphOptions) ([]*pb.ExportFile, error) {
+	root, err := parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graph.Build(r.tree, r.pages, graph.Options{
+		MaxDepth:         int(opts.GetMaxDepth()),
+		CollapseSiblings: int(opts.GetCollapseSiblings()),
+		ColorByStatus:    opts.GetColorByStatus(),
+		Links:            opts.GetLinks(),
+	})
+
+	var buf bytes.Buffer
+	if err := graphWriters[format](&buf, g); err != nil {
+		return nil, err
+	}
+
+	return []*pb.ExportFile{{Name: root.Hostname() + graphExtensions[format], Content: buf.Bytes()}}, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"sort"
+
+	pb "github.com/wrrn/crawler/pkg/crawler"
+)
+
+// defaultExternalLinksPerSecond is how many external links are checked each
+// second if the crawl doesn't say.
+const defaultExternalLinksPerSecond = 2
+
+// ExternalLinkReport summarizes the links from a crawl to other sites: which
+// domains are linked to, how often, and which links are broken.
+func (s *Service) ExternalLinkReport(ctx context.Context, req *pb.ExternalLinkReportRequest) (*pb.ExternalLinkReportResponse, error) {
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ExternalLinkReportResponse{Id: r.id, Checked: r.externalChecks != nil}
+	links := map[string]*pb.ExternalLink{}
+	domains := map[string]*pb.ExternalDomain{}
+	domainPages := map[string]map[string]bool{}
+	for _, page := range r.pages {
+		for _, raw := range page.ExternalLinks {
+			link, found := links[raw]
+			if !found {
+				link = &pb.ExternalLink{Url: raw}
+				if check, checked := r.externalChecks[raw]; checked {
+					link.Checked = true
+					link.Status = int32(check.Status)
+					link.Error = check.Error
+					link.Broken = check.Broken()
+				}
+				links[raw] = link
+
+				domain := linkDomain(raw)
+				if domains[domain] == nil {
+					domains[domain] = &pb.ExternalDomain{Domain: domain}
+					domainPages[domain] = map[string]bool{}
+				}
+				domains[domain].Links++
+				if link.Broken {
+					domains[domain].Broken++
+				}
+			}
+			link.Pages = append(link.Pages, page.URL)
+			domainPages[linkDomain(raw)][page.URL] = true
+		}
+	}
+
+	for _, link := range links {
+		sort.Strings(link.Pages)
+		resp.Links = append(resp.Links, link)
+	}
+
+	for domain, d := range domains {
+		d.Pages = int32(len(domainPages[domain]))
+		resp.Domains = append(resp.Domains, d)
+	}
+
+	sort.Slice(resp.Links, func(i, j int) bool {
+		if len(resp.Links[i].GetPages()) != len(resp.Links[j].GetPages()) {
+			return len(resp.Links[i].GetPages()) > len(resp.Links[j].GetPages())
+		}
+		return resp.Links[i].GetUrl() < resp.Links[j].GetUrl()
+	})
+
+	sort.Slice(resp.Domains, func(i, j int) bool {
+		if resp.Domains[i].GetPages() != resp.Domains[j].GetPages() {
+			return resp.Domains[i].GetPages() > resp.Domains[j].GetPages()
+		}
+		return resp.Domains[i].GetDomain() < resp.Domains[j].GetDomain()
+	})
+
+	return resp, nil
+}
+
+// linkDomain returns the host that the link is to.
+func linkDomain(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	return u.Hostname()
+}
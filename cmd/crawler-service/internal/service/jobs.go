@@ -0,0 +1,195 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/extract"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/limiter"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/mirror"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/search"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/spider"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/warc"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// job is a crawl that is either queued or in progress.
+type job struct {
+	id string
+
+	// url is the URL as it was requested, it is used as the key for the job and
+	// its result. target is the parsed URL that is crawled.
+	url    string
+	target *url.URL
+
+	// owner is the subject of the identity that started the crawl. It is empty
+	// when authentication is disabled.
+	owner string
+
+	// priority is the job's share of the fetch slots.
+	priority int
+
+	options *pb.CrawlOptions
+
+	// rules are the crawl's compiled extraction rules.
+	rules []*extract.Rule
+
+	// spider and fetches are nil until the job starts running. archive is
+	// also nil if the job isn't archived.
+	started time.Time
+	spider  *spider.Spider
+	fetches *limiter.Queue
+	archive *warc.Writer
+
+	// replay, if set, serves the job's requests from an archived crawl instead
+	// of the network.
+	replay *warc.Archive
+
+	// mirror is nil until the job starts running, or if it isn't mirrored.
+	mirror *mirror.Mirror
+
+	// stop and finish make sure that the spider is only stopped, and the
+	// result is only stored, once.
+	stop   sync.Once
+	finish sync.Once
+}
+
+// newID returns a random ID for a job.
+func newID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// submitJob runs the job if there is a free crawl slot, otherwise it is queued
+// until one becomes free. It returns true if the job was queued.
+func (s *Service) submitJob(j *job) (bool, error) {
+	s.jobsLock.Lock()
+	defer s.jobsLock.Unlock()
+
+	if _, found := s.jobs[j.url]; found {
+		return false, status.Errorf(codes.FailedPrecondition, "Already crawling %s", j.url)
+	}
+	s.jobs[j.url] = j
+
+	if s.maxCrawls <= 0 || s.running < s.maxCrawls {
+		s.runJob(j)
+		return false, nil
+	}
+
+	// Keep the queue ordered by priority, and by the order the jobs were
+	// submitted for jobs with the same priority.
+	s.pending = append(s.pending, j)
+	sort.SliceStable(s.pending, func(a, b int) bool {
+		return s.pending[a].priority > s.pending[b].priority
+	})
+
+	return true, nil
+}
+
+// runJob starts the job's spider. The caller must hold the jobs lock.
+func (s *Service) runJob(j *job) {
+	s.running++
+	j.started = time.Now()
+	j.fetches = s.fetches.Queue(j.priority)
+	if s.archiveAll || j.options.GetArchive() {
+		j.archive = s.newArchive(j)
+	}
+	if j.options.GetMirror() != nil {
+		j.mirror = s.newMirror(j)
+	}
+	j.spider = spider.New(s.spiderOptions(j)...)
+	go j.spider.Crawl(j.target)
+
+	// Store the result as soon as the spider runs out of pages to crawl.
+	go func() {
+		<-j.spider.Done()
+		s.finishJob(j)
+	}()
+}
+
+// stopJob stops a running job and waits for its result to be stored, or
+// removes a queued job from the queue.
+func (s *Service) stopJob(j *job) {
+	s.jobsLock.Lock()
+	if j.spider == nil {
+		for i, p := range s.pending {
+			if p == j {
+				s.pending = append(s.pending[:i], s.pending[i+1:]...)
+				break
+			}
+		}
+		delete(s.jobs, j.url)
+		s.jobsLock.Unlock()
+		return
+	}
+	s.jobsLock.Unlock()
+
+	j.stop.Do(j.spider.Stop)
+	s.finishJob(j)
+}
+
+// finishJob stores the result of a job whose spider has finished, and starts
+// the next queued job.
+func (s *Service) finishJob(j *job) {
+	j.finish.Do(func() {
+		pages := j.spider.Pages()
+		sitemapsRead, sitemapPages := j.spider.SitemapsRead()
+		s.addTree(j.url, result{
+			id:             j.id,
+			tree:           j.spider.SiteTree(),
+			pages:          pages,
+			sitemapsRead:   sitemapsRead,
+			sitemapPages:   sitemapPages,
+			sitemapURLs:    j.spider.SitemapURLs(),
+			assetChecks:    j.spider.AssetChecks(),
+			externalChecks: j.spider.ExternalLinkChecks(),
+			skipped:        j.spider.SkippedURLs(),
+			index:          search.NewIndex(pages),
+			started:        j.started,
+			finished:       time.Now(),
+			owner:          j.owner,
+		})
+
+		s.jobsLock.Lock()
+		defer s.jobsLock.Unlock()
+
+		j.fetches.Close()
+		if j.archive != nil {
+			if err := j.archive.Close(); err != nil {
+				log.Printf("Failed to close the archive of crawl %s: %v", j.id, err)
+			}
+		}
+		if j.mirror != nil {
+			files, size := j.mirror.Stats()
+			log.Printf("Mirrored %d files (%d bytes) from crawl %s of %s", files, size, j.id, j.url)
+		}
+		delete(s.jobs, j.url)
+		s.running--
+
+		for len(s.pending) > 0 && (s.maxCrawls <= 0 || s.running < s.maxCrawls) {
+			next := s.pending[0]
+			s.pending = s.pending[1:]
+			s.runJob(next)
+		}
+	})
+}
+
+// getJob returns the job crawling the given url. False will be returned if
+// there isn't a job crawling the given url.
+func (s *Service) getJob(url string) (*job, bool) {
+	s.jobsLock.Lock()
+	j, ok := s.jobs[url]
+	s.jobsLock.Unlock()
+	return j, ok
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"path/filepath"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/mirror"
+)
+
+// newMirror returns the mirror that a job's pages and assets are saved to. The
+// limits asked for by the job are used if they are smaller than the service's.
+func (s *Service) newMirror(j *job) *mirror.Mirror {
+	opts := j.options.GetMirror()
+	return mirror.New(
+		filepath.Join(s.mirrorDir, j.id),
+		j.target,
+		smallestLimit(s.mirrorMaxTotal, opts.GetMaxTotalSize()),
+		smallestLimit(s.mirrorMaxFile, opts.GetMaxFileSize()),
+	)
+}
+
+// smallestLimit returns the smaller of two size limits, where a limit <= 0
+// means there isn't a limit.
+func smallestLimit(a, b int64) int64 {
+	switch {
+	case a <= 0:
+		return b
+	case b <= 0 || a < b:
+		return a
+	default:
+		return b
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"time"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/limiter"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/netpolicy"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/warc"
+)
+
+// DefaultRetainCrawls is the number of results kept per URL when
+// WithRetainCrawls isn't used.
+const DefaultRetainCrawls = 5
+
+// Option configures optional behaviour of a Service.
+type Option func(*Service)
+
+// WithRetainCrawls keeps at most the last n crawl results for each URL. A
+// value <= 0 keeps every result.
+func WithRetainCrawls(n int) Option {
+	return func(s *Service) {
+		s.retainCrawls = n
+	}
+}
+
+// WithRetainFor expires crawl results once they are older than d. A value <= 0
+// keeps results forever.
+func WithRetainFor(d time.Duration) Option {
+	return func(s *Service) {
+		s.retainFor = d
+	}
+}
+
+// WithNetworkPolicy only allows crawls of hosts that the policy allows, and
+// applies the policy to every request the spiders make.
+func WithNetworkPolicy(p *netpolicy.Policy) Option {
+	return func(s *Service) {
+		s.policy = p
+		s.client = p.Client()
+	}
+}
+
+// WithArchive makes crawls started with the archive option write every request
+// and response to WARC files in dir, which are rotated once they are larger
+// than maxSize bytes. If all is true then every crawl is archived.
+func WithArchive(dir string, maxSize int64, all bool) Option {
+	return func(s *Service) {
+		s.archiveDir = dir
+		s.archiveMaxSize = maxSize
+		s.archiveAll = all
+	}
+}
+
+// WithMirror makes crawls started with the mirror option save their pages and
+// assets to a directory named after the crawl's ID in dir. maxTotal and maxFile
+// limit the size of each mirror and of each file in it. Crawls can ask for
+// smaller limits, but not larger ones. Values <= 0 don't limit the size.
+func WithMirror(dir string, maxTotal, maxFile int64) Option {
+	return func(s *Service) {
+		s.mirrorDir = dir
+		s.mirrorMaxTotal = maxTotal
+		s.mirrorMaxFile = maxFile
+	}
+}
+
+// WithReplay makes every crawl fetch its pages from the archive instead of the
+// network.
+func WithReplay(a *warc.Archive) Option {
+	return func(s *Service) {
+		s.replay = a
+	}
+}
+
+// WithMaxCrawls limits the number of crawls that run at once to n. Crawls
+// started beyond the limit are queued until a running crawl finishes. A value
+// <= 0 doesn't limit the number of crawls.
+func WithMaxCrawls(n int) Option {
+	return func(s *Service) {
+		s.maxCrawls = n
+	}
+}
+
+// WithMaxFetches limits the number of requests in flight across every crawl to
+// n. The requests are shared fairly between the running crawls according to
+// their priority. A value <= 0 doesn't limit the number of requests.
+func WithMaxFetches(n int) Option {
+	return func(s *Service) {
+		s.fetches = limiter.New(n)
+		s.maxFetches = n
+	}
+}
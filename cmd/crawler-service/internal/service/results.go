@@ -0,0 +1,55 @@
+package service
+
+import (
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/extract"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Results streams a record for each page of a crawl that its extraction rules
+// applied to, sorted by the page's URL.
+func (s *Service) Results(req *pb.ResultsRequest, stream pb.Crawler_ResultsServer) error {
+	r, err := s.getResult(stream.Context(), req.GetUrl(), req.GetId())
+	if err != nil {
+		return err
+	}
+
+	for _, p := range sortedPages(r.pages) {
+		if !p.Extracted {
+			continue
+		}
+
+		record := &pb.Record{Url: p.URL}
+		for _, f := range p.Fields {
+			record.Fields = append(record.Fields, &pb.Field{Name: f.Name, Values: f.Values})
+		}
+
+		if err := stream.Send(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compileRules compiles a crawl's extraction rules, and returns an
+// InvalidArgument error if any of them are invalid.
+func compileRules(rules []*pb.ExtractionRule) ([]*extract.Rule, error) {
+	compiled := make([]*extract.Rule, 0, len(rules))
+	names := map[string]bool{}
+	for _, r := range rules {
+		if names[r.GetName()] {
+			return nil, status.Errorf(codes.InvalidArgument, "There is more than one rule named %s", r.GetName())
+		}
+		names[r.GetName()] = true
+
+		rule, err := extract.NewRule(r.GetName(), r.GetSelector(), r.GetAttribute(), r.GetUrlPattern())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid extraction rule: %v", err)
+		}
+		compiled = append(compiled, rule)
+	}
+
+	return compiled, nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// RunJanitor applies the retention policies to the stored crawl results every
+// interval until the context is cancelled. The interval must be positive.
+func (s *Service) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			s.expireTrees(now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// expireTrees drops the results that are past the retention limits as of now.
+func (s *Service) expireTrees(now time.Time) {
+	s.treesLock.Lock()
+	defer s.treesLock.Unlock()
+
+	for url, results := range s.trees {
+		if s.retainCrawls > 0 && len(results) > s.retainCrawls {
+			results = results[len(results)-s.retainCrawls:]
+		}
+
+		if s.retainFor > 0 {
+			// Results are stored oldest first so we only need to find the first
+			// one that is still fresh.
+			i := 0
+			for i < len(results) && now.Sub(results[i].finished) > s.retainFor {
+				i++
+			}
+			results = results[i:]
+		}
+
+		if len(results) == 0 {
+			delete(s.trees, url)
+			continue
+		}
+		s.trees[url] = results
+	}
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/schedule"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// minScheduleInterval is the shortest interval a schedule may run at, so
+	// that a schedule can't be used to hammer a site.
+	minScheduleInterval = time.Minute
+
+	// maxScheduledRuns is the number of runs kept in a schedule's history.
+	maxScheduledRuns = 20
+
+	// schedulerTick is how often the scheduler looks for schedules that are due.
+	schedulerTick = time.Second
+)
+
+// crawlSchedule is a URL that is crawled at regular times.
+type crawlSchedule struct {
+	id       string
+	url      string
+	cron     string
+	interval time.Duration
+	spec     schedule.Spec
+	options  *pb.CrawlOptions
+	owner    string
+	next     time.Time
+
+	// runs are the most recent runs of the schedule, oldest first.
+	runs []scheduledRun
+}
+
+// scheduledRun is a single time that a schedule was due to run.
+type scheduledRun struct {
+	time    time.Time
+	jobID   string
+	skipped bool
+	err     string
+}
+
+// CreateSchedule creates a schedule that starts crawling a URL at regular
+// times.
+func (s *Service) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.CreateScheduleResponse, error) {
+	url, err := parseURL(req.GetUrl())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s was not a valid URL", req.GetUrl())
+	}
+
+	if err := authorizeCrawl(ctx, url.Hostname()); err != nil {
+		return nil, err
+	}
+
+	if s.policy != nil {
+		if err := s.policy.CheckHost(ctx, url.Hostname()); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "Not allowed to crawl %s: %v", req.GetUrl(), err)
+		}
+	}
+
+	// The options are checked again each time a crawl is started, but invalid
+	// options should be reported now rather than every time the schedule runs.
+	opts := req.GetOptions()
+	if _, err := s.checkOptions(opts); err != nil {
+		return nil, err
+	}
+	if len(opts.GetReplay()) > 0 || len(opts.GetReplayFiles()) > 0 {
+		if _, err := s.openReplay(ctx, opts.GetReplay(), opts.GetReplayFiles()); err != nil {
+			return nil, err
+		}
+	}
+
+	sched := &crawlSchedule{
+		id:      newID(),
+		url:     req.GetUrl(),
+		cron:    req.GetCron(),
+		options: req.GetOptions(),
+		owner:   subject(ctx),
+	}
+
+	switch {
+	case len(req.GetCron()) > 0 && req.GetInterval() != nil:
+		return nil, status.Error(codes.InvalidArgument, "Only one of cron or interval may be set")
+
+	case len(req.GetCron()) > 0:
+		if sched.spec, err = schedule.ParseCron(req.GetCron()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid cron expression: %v", err)
+		}
+
+	case req.GetInterval() != nil:
+		if sched.interval, err = ptypes.Duration(req.GetInterval()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid interval: %v", err)
+		}
+
+		if sched.interval < minScheduleInterval {
+			return nil, status.Errorf(codes.InvalidArgument, "The interval must be at least %s", minScheduleInterval)
+		}
+		sched.spec = schedule.Every(sched.interval)
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "One of cron or interval must be set")
+	}
+
+	sched.next = sched.spec.Next(time.Now())
+	if sched.next.IsZero() {
+		return nil, status.Errorf(codes.InvalidArgument, "The cron expression %q never runs", req.GetCron())
+	}
+
+	s.schedulesLock.Lock()
+	s.schedules[sched.id] = sched
+	pbSchedule := sched.proto()
+	s.schedulesLock.Unlock()
+
+	return &pb.CreateScheduleResponse{Schedule: pbSchedule}, nil
+}
+
+// ListSchedules shows the schedules that the caller may read, which are those
+// it created unless it is an admin, and their recent runs.
+func (s *Service) ListSchedules(ctx context.Context, _ *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	s.schedulesLock.Lock()
+	defer s.schedulesLock.Unlock()
+
+	schedules := make([]*pb.Schedule, 0, len(s.schedules))
+	for _, sched := range s.schedules {
+		if canRead(ctx, sched.owner) {
+			schedules = append(schedules, sched.proto())
+		}
+	}
+
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].GetNextRun().GetSeconds() < schedules[j].GetNextRun().GetSeconds()
+	})
+
+	return &pb.ListSchedulesResponse{Schedules: schedules}, nil
+}
+
+// DeleteSchedule removes a schedule. Crawls that it has already started are
+// not stopped.
+func (s *Service) DeleteSchedule(ctx context.Context, req *pb.DeleteScheduleRequest) (*pb.DeleteScheduleResponse, error) {
+	s.schedulesLock.Lock()
+	defer s.schedulesLock.Unlock()
+
+	sched, found := s.schedules[req.GetId()]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No schedule with the ID %s", req.GetId())
+	}
+
+	if err := authorizeManage(ctx, sched.owner); err != nil {
+		return nil, err
+	}
+
+	delete(s.schedules, req.GetId())
+
+	return &pb.DeleteScheduleResponse{}, nil
+}
+
+// RunScheduler starts the crawls for the schedules as they become due until
+// the context is cancelled.
+func (s *Service) RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(schedulerTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			s.runSchedules(ctx, now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runSchedules starts a crawl for each schedule that is due as of now. A run is
+// skipped if the URL is still being crawled.
+func (s *Service) runSchedules(ctx context.Context, now time.Time) {
+	s.schedulesLock.Lock()
+	var due []*crawlSchedule
+	for _, sched := range s.schedules {
+		if !now.Before(sched.next) {
+			due = append(due, sched)
+			sched.next = sched.spec.Next(now)
+		}
+	}
+	s.schedulesLock.Unlock()
+
+	for _, sched := range due {
+		run := scheduledRun{time: now}
+		if _, found := s.getJob(sched.url); found {
+			run.skipped = true
+		} else if url, err := parseURL(sched.url); err != nil {
+			run.err = err.Error()
+		} else if j, _, err := s.startCrawl(ctx, sched.url, url, sched.options, sched.owner); err != nil {
+			run.err = status.Convert(err).Message()
+		} else {
+			run.jobID = j.id
+		}
+
+		s.schedulesLock.Lock()
+		sched.runs = append(sched.runs, run)
+		if len(sched.runs) > maxScheduledRuns {
+			sched.runs = sched.runs[len(sched.runs)-maxScheduledRuns:]
+		}
+		s.schedulesLock.Unlock()
+	}
+}
+
+// proto converts the schedule to its protobuf representation. The caller must
+// hold the schedules lock.
+func (c *crawlSchedule) proto() *pb.Schedule {
+	sched := &pb.Schedule{
+		Id:      c.id,
+		Url:     c.url,
+		Cron:    c.cron,
+		Options: c.options,
+		Owner:   c.owner,
+		Runs:    make([]*pb.ScheduledRun, 0, len(c.runs)),
+	}
+
+	if c.interval > 0 {
+		sched.Interval = ptypes.DurationProto(c.interval)
+	}
+
+	// The timestamps are always valid so we can ignore the errors.
+	sched.NextRun, _ = ptypes.TimestampProto(c.next)
+	for _, r := range c.runs {
+		t, _ := ptypes.TimestampProto(r.time)
+		sched.Runs = append(sched.Runs, &pb.ScheduledRun{
+			Time:    t,
+			JobId:   r.jobID,
+			Skipped: r.skipped,
+			Error:   r.err,
+		})
+	}
+
+	return sched
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/search"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultSearchLimit is the number of results returned by a search that
+// doesn't set a limit.
+const defaultSearchLimit = 20
+
+// Search finds the pages of a crawl whose text contains every word and phrase
+// of the query, along with where they are in the site tree.
+func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "The limit can't be negative")
+	}
+
+	query, err := search.ParseQuery(req.GetQuery())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid query: %v", err)
+	}
+
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	matches := r.index.Search(query)
+	resp := &pb.SearchResponse{Id: r.id, Total: int32(len(matches))}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	for _, m := range matches {
+		resp.Results = append(resp.Results, &pb.SearchResult{
+			Url:      m.URL,
+			Title:    m.Title,
+			Snippet:  m.Snippet,
+			Score:    int32(m.Score),
+			TreePath: treePath(r.tree, m.URL),
+		})
+	}
+
+	return resp, nil
+}
+
+// treePath returns the values of the nodes from the root of the tree down to
+// the page at the given URL, or nil if the page isn't in the tree, which
+// happens when a canonical tree replaced it with its canonical URL.
+func treePath(tree site.Tree, pageURL string) []string {
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return nil
+	}
+
+	path := []string{tree.Value}
+	node := &tree
+	for _, segment := range strings.Split(strings.Trim(u.Path, "/"), "/") {
+		if len(segment) == 0 {
+			continue
+		}
+
+		var child *site.Tree
+		for _, c := range node.Children {
+			if c.Value == segment {
+				child = c
+				break
+			}
+		}
+		if child == nil {
+			return nil
+		}
+
+		path = append(path, segment)
+		node = child
+	}
+
+	return path
+}
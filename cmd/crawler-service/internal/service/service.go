@@ -2,75 +2,359 @@ package service
 
 import (
 	"context"
+	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/extract"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/fingerprint"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/limiter"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/netpolicy"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/search"
 	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
 	"github.com/wrrn/crawler/cmd/crawler-service/internal/spider"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/trap"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/warc"
 	pb "github.com/wrrn/crawler/pkg/crawler"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // New returns a new Service that implements crawler.CrawlerService.
-func New() *Service {
-	return &Service{
-		activeSpiders: map[string]*spider.Spider{},
-		spidersLock:   sync.RWMutex{},
-		trees:         map[string]site.Tree{},
-		treesLock:     sync.RWMutex{},
+func New(opts ...Option) *Service {
+	s := &Service{
+		jobs:         map[string]*job{},
+		jobsLock:     sync.Mutex{},
+		fetches:      limiter.New(0),
+		schedules:    map[string]*crawlSchedule{},
+		trees:        map[string][]result{},
+		treesLock:    sync.RWMutex{},
+		retainCrawls: DefaultRetainCrawls,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 // Service accepts incoming gRPC requests to start and stop crawling urls, and
 // to list the site trees for all of the parsed URLs.
 type Service struct {
-	activeSpiders map[string]*spider.Spider
-	spidersLock   sync.RWMutex
+	// jobs holds the crawls that are queued or in progress keyed by their URL.
+	// pending are the queued jobs in the order they should be run, and running
+	// is the number of jobs that are in progress.
+	jobs     map[string]*job
+	pending  []*job
+	running  int
+	jobsLock sync.Mutex
+
+	// maxCrawls is the number of jobs that may run at once. A value <= 0 doesn't
+	// limit the number of jobs.
+	maxCrawls int
+
+	// fetches limits the number of requests in flight across every job, and
+	// maxFetches is its capacity. A job's spider doesn't start more workers
+	// than it could have requests in flight.
+	fetches    *limiter.Limiter
+	maxFetches int
+
+	// schedules holds the recurring crawls keyed by their ID.
+	schedules     map[string]*crawlSchedule
+	schedulesLock sync.Mutex
 
-	// Use a map here so that we can just overwrite the existing site trees, when
-	// we receive a new request. Also gives us faster lookups for start and stop.
-	trees     map[string]site.Tree
+	// trees holds the crawl results for each URL, oldest first. Use a map here
+	// so that we get fast lookups for list and delete.
+	trees     map[string][]result
 	treesLock sync.RWMutex
+
+	// retainCrawls is the maximum number of results kept per URL. A value <= 0
+	// keeps every result.
+	retainCrawls int
+
+	// retainFor is how long a result is kept before the janitor expires it. A
+	// value <= 0 keeps results forever.
+	retainFor time.Duration
+
+	// policy restricts which hosts may be crawled. The spiders make their
+	// requests with client so that the policy is also applied to every fetch.
+	policy *netpolicy.Policy
+	client *http.Client
+
+	// archiveDir is the directory that crawls are archived to as WARC files,
+	// or empty if crawls can't be archived. archiveAll archives every crawl
+	// rather than only the crawls started with the archive option.
+	archiveDir     string
+	archiveMaxSize int64
+	archiveAll     bool
+
+	// replay, if set, serves every crawl from archived responses instead of
+	// the network.
+	replay *warc.Archive
+
+	// mirrorDir is the directory that crawls are mirrored to, or empty if
+	// crawls can't be mirrored.
+	mirrorDir      string
+	mirrorMaxTotal int64
+	mirrorMaxFile  int64
 }
 
-// Start signals the service to start crawling the given URL.
-func (s *Service) Start(_ context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
+// result is the outcome of a single crawl of a URL.
+type result struct {
+	id    string
+	tree  site.Tree
+	pages map[string]*site.Page
+
+	// sitemapsRead is true if the crawl read the site's sitemaps, and
+	// sitemapPages is the number of pages they list on any host. sitemapURLs
+	// are the URLs on the site that they list.
+	sitemapsRead bool
+	sitemapPages int
+	sitemapURLs  []string
+
+	// assetChecks are the results of requesting the assets used by the pages
+	// keyed by their URL, if the crawl checked them.
+	assetChecks map[string]*site.URLCheck
+
+	// externalChecks are the results of requesting the links to other sites
+	// keyed by their URL, if the crawl checked them.
+	externalChecks map[string]*site.URLCheck
+
+	// skipped are the URLs that were skipped as crawler traps.
+	skipped []site.SkippedURL
+
+	// index is the inverted index of the text of the crawl's pages.
+	index *search.Index
+
+	started  time.Time
+	finished time.Time
+	owner    string
+}
+
+// Start signals the service to start crawling the given URL. The crawl is
+// queued if the service is already running as many crawls as it allows.
+func (s *Service) Start(ctx context.Context, req *pb.StartRequest) (*pb.StartResponse, error) {
 	url, err := parseURL(req.GetUrl())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s was not a valid URL", req.GetUrl())
 	}
 
-	if _, found := s.getSpider(req.GetUrl()); found {
-		return nil, status.Errorf(codes.FailedPrecondition, "Already crawling %s", req.GetUrl())
+	if err := authorizeCrawl(ctx, url.Hostname()); err != nil {
+		return nil, err
 	}
 
-	spider := spider.New()
-	go spider.Crawl(url)
+	j, queued, err := s.startCrawl(ctx, req.GetUrl(), url, req.GetOptions(), subject(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartResponse{Id: j.id, Queued: queued}, nil
+}
 
-	s.addSpider(req.GetUrl(), spider)
+// startCrawl checks the URL against the network policy and submits a job to
+// crawl it on behalf of owner. It returns true if the job was queued.
+func (s *Service) startCrawl(ctx context.Context, rawURL string, url *url.URL, opts *pb.CrawlOptions, owner string) (*job, bool, error) {
+	// Replayed crawls don't make any requests so the policy doesn't apply.
+	replaying := s.replay != nil || len(opts.GetReplay()) > 0 || len(opts.GetReplayFiles()) > 0
+	if s.policy != nil && !replaying {
+		if err := s.policy.CheckHost(ctx, url.Hostname()); err != nil {
+			return nil, false, status.Errorf(codes.PermissionDenied, "Not allowed to crawl %s: %v", rawURL, err)
+		}
+	}
+
+	rules, err := s.checkOptions(opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	j := &job{
+		id:       newID(),
+		url:      rawURL,
+		target:   url,
+		owner:    owner,
+		priority: int(opts.GetPriority()),
+		options:  opts,
+		rules:    rules,
+	}
 
-	return &pb.StartResponse{}, nil
+	if len(opts.GetReplay()) > 0 || len(opts.GetReplayFiles()) > 0 {
+		archive, err := s.openReplay(ctx, opts.GetReplay(), opts.GetReplayFiles())
+		if err != nil {
+			return nil, false, err
+		}
+		j.replay = archive
+	}
+
+	queued, err := s.submitJob(j)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return j, queued, nil
 }
 
-// Stop signals the service to stop crawling the given URL.
-func (s *Service) Stop(_ context.Context, req *pb.StopRequest) (*pb.StopResponse, error) {
-	spider, found := s.getSpider(req.GetUrl())
+// checkOptions returns an error if the crawl options are invalid or need
+// something the service isn't configured for, and otherwise the compiled
+// extraction rules. The files to replay are checked when they are opened.
+func (s *Service) checkOptions(opts *pb.CrawlOptions) ([]*extract.Rule, error) {
+	if opts.GetArchive() && len(s.archiveDir) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "The service isn't configured to archive crawls")
+	}
+
+	if _, ok := pb.NofollowPolicy_name[int32(opts.GetNofollow())]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown nofollow policy %d", opts.GetNofollow())
+	}
+
+	if d := opts.GetDuplicateDistance(); d < 0 || d > maxDistance {
+		return nil, status.Errorf(codes.InvalidArgument, "The duplicate distance must be between 0 and %d", maxDistance)
+	}
+
+	rules, err := compileRules(opts.GetRules())
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.GetMirror() != nil && len(s.mirrorDir) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "The service isn't configured to mirror crawls")
+	}
+
+	return rules, nil
+}
+
+// Stop signals the service to stop crawling the given URL. A queued crawl is
+// removed from the queue without producing a site tree, and stopping a crawl
+// that has already finished does nothing.
+func (s *Service) Stop(ctx context.Context, req *pb.StopRequest) (*pb.StopResponse, error) {
+	job, found := s.getJob(req.GetUrl())
 	if !found {
+		// Crawls finish on their own, so stopping a crawl that has already
+		// finished succeeds like it did when crawls ran until stopped.
+		if r, finished := s.getLatestTree(req.GetUrl()); finished {
+			if err := authorizeManage(ctx, r.owner); err != nil {
+				return nil, err
+			}
+			return &pb.StopResponse{}, nil
+		}
+
 		return nil, status.Errorf(codes.InvalidArgument, "Start crawling %s before calling Stop", req.GetUrl())
 	}
 
-	spider.Stop()
-	s.addTree(req.GetUrl(), spider.SiteTree())
-	s.removeSpider(req.GetUrl())
+	if err := authorizeManage(ctx, job.owner); err != nil {
+		return nil, err
+	}
+
+	s.stopJob(job)
 
 	return &pb.StopResponse{}, nil
 }
 
 // Show the current site tree for all the given URLs.
-func (s *Service) List(context.Context, *pb.ListRequest) (*pb.ListResponse, error) {
-	return &pb.ListResponse{SiteTrees: s.getProtoTrees()}, nil
+func (s *Service) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	return &pb.ListResponse{SiteTrees: s.getProtoTrees(ctx, req.GetIncludePages())}, nil
+}
+
+// Delete removes all of the stored site trees for the given URL.
+func (s *Service) Delete(ctx context.Context, req *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	if _, found := s.getJob(req.GetUrl()); found {
+		return nil, status.Errorf(codes.FailedPrecondition, "Stop crawling %s before calling Delete", req.GetUrl())
+	}
+
+	for _, owner := range s.getTreeOwners(req.GetUrl()) {
+		if err := authorizeManage(ctx, owner); err != nil {
+			return nil, err
+		}
+	}
+
+	deleted := s.removeTrees(req.GetUrl())
+	if deleted == 0 {
+		return nil, status.Errorf(codes.NotFound, "No site trees found for %s", req.GetUrl())
+	}
+
+	return &pb.DeleteResponse{Deleted: int32(deleted)}, nil
+}
+
+// spiderOptions returns the options that a job's spider is created with.
+func (s *Service) spiderOptions(j *job) []spider.Option {
+	opts := []spider.Option{spider.WithLimiter(j.fetches), spider.WithWorkers(s.maxFetches)}
+
+	client := http.DefaultClient
+	switch {
+	case j.replay != nil:
+		client = &http.Client{Transport: j.replay}
+	case s.replay != nil:
+		client = &http.Client{Transport: s.replay}
+	case s.client != nil:
+		client = s.client
+	}
+	if j.archive != nil {
+		// Record at the transport so that each redirect is archived as its
+		// own request and response.
+		recording := *client
+		recording.Transport = warc.NewRecorder(client.Transport, j.archive)
+		client = &recording
+	}
+	opts = append(opts, spider.WithFetcher(client))
+
+	if j.mirror != nil {
+		opts = append(opts, spider.WithMirror(j.mirror))
+	} else if prev, found := s.getLatestTree(j.url); found && !j.options.GetFullRecrawl() {
+		opts = append(opts, spider.WithPrevious(prev.pages))
+	}
+
+	switch j.options.GetNofollow() {
+	case pb.NofollowPolicy_NOFOLLOW_PAGES:
+		opts = append(opts, spider.WithNofollowPolicy(spider.NofollowPages))
+	case pb.NofollowPolicy_NOFOLLOW_ALL:
+		opts = append(opts, spider.WithNofollowPolicy(spider.NofollowAll))
+	}
+
+	if j.options.GetSkipDuplicates() {
+		distance := int(j.options.GetDuplicateDistance())
+		if distance == 0 {
+			distance = fingerprint.DefaultMaxDistance
+		}
+		opts = append(opts, spider.WithDuplicateSkipping(distance))
+	}
+
+	if traps := j.options.GetTraps(); !traps.GetDisabled() {
+		opts = append(opts, spider.WithTrapDetection(trap.Options{
+			MaxDepth:         int(traps.GetMaxDepth()),
+			MaxRepeats:       int(traps.GetMaxRepeats()),
+			MaxQueryVariants: int(traps.GetMaxQueryVariants()),
+			MaxPages:         int(traps.GetMaxPages()),
+		}))
+	}
+
+	if len(j.rules) > 0 {
+		opts = append(opts, spider.WithRules(j.rules))
+	}
+
+	if j.options.GetCanonicalTree() {
+		opts = append(opts, spider.WithCanonicalTree())
+	}
+
+	if j.options.GetUseSitemaps() {
+		opts = append(opts, spider.WithSitemaps())
+	}
+
+	if j.options.GetCheckAssets() {
+		opts = append(opts, spider.WithAssetChecks())
+	}
+
+	if j.options.GetCheckExternalLinks() {
+		rate := j.options.GetExternalLinksPerSecond()
+		if rate <= 0 {
+			rate = defaultExternalLinksPerSecond
+		}
+		opts = append(opts, spider.WithExternalLinkChecks(time.Duration(float64(time.Second)/rate)))
+	}
+
+	return opts
 }
 
 // parseURL will convert the string into an url.URL. It will return errors if
@@ -109,47 +393,120 @@ func parseURL(raw string) (*url.URL, error) {
 	return url, nil
 }
 
-// addSpider will associate the give spider with give URL so that it can stopped
-// later.
-func (s *Service) addSpider(url string, spider *spider.Spider) {
-	s.spidersLock.Lock()
-	s.activeSpiders[url] = spider
-	s.spidersLock.Unlock()
+// addTree will add a result for the give URL to the cache of site trees,
+// dropping the oldest results if there are more than the service retains.
+func (s *Service) addTree(url string, r result) {
+	s.treesLock.Lock()
+	results := append(s.trees[url], r)
+	if s.retainCrawls > 0 && len(results) > s.retainCrawls {
+		results = results[len(results)-s.retainCrawls:]
+	}
+	s.trees[url] = results
+	s.treesLock.Unlock()
 }
 
-// removeSpider removes the spider from active spider pool and returns the
-// spider. False will be returned if there isn't a spider crawling the given
-// url.
-func (s *Service) removeSpider(url string) {
-	s.spidersLock.Lock()
-	delete(s.activeSpiders, url)
-	s.spidersLock.Unlock()
+// removeTrees removes all of the results for the given URL and returns how
+// many were removed.
+func (s *Service) removeTrees(url string) int {
+	s.treesLock.Lock()
+	removed := len(s.trees[url])
+	delete(s.trees, url)
+	s.treesLock.Unlock()
+	return removed
 }
 
-func (s *Service) getSpider(url string) (*spider.Spider, bool) {
-	s.spidersLock.RLock()
-	spider, ok := s.activeSpiders[url]
-	s.spidersLock.RUnlock()
-	return spider, ok
+// getLatestTree returns the most recent result for the given URL.
+func (s *Service) getLatestTree(url string) (result, bool) {
+	s.treesLock.RLock()
+	defer s.treesLock.RUnlock()
+
+	results := s.trees[url]
+	if len(results) == 0 {
+		return result{}, false
+	}
+
+	return results[len(results)-1], true
 }
 
-// addTree will add a tree for the give URL to the cache of site trees.
-func (s *Service) addTree(url string, tree site.Tree) {
-	s.treesLock.Lock()
-	s.trees[url] = tree
-	s.treesLock.Unlock()
+// getResult returns the result with the given ID for the URL, or the most
+// recent result that the caller may read if the ID is empty. A NotFound error
+// is returned if there isn't a result, and a PermissionDenied error if the
+// caller may not read it.
+func (s *Service) getResult(ctx context.Context, url, id string) (result, error) {
+	s.treesLock.RLock()
+	defer s.treesLock.RUnlock()
+
+	if len(id) == 0 {
+		readable := readableResults(ctx, s.trees[url])
+		if len(readable) == 0 {
+			return result{}, status.Errorf(codes.NotFound, "%s has not been crawled", url)
+		}
+		return readable[len(readable)-1], nil
+	}
+
+	r, found := findResult(s.trees[url], id)
+	if !found {
+		return result{}, status.Errorf(codes.NotFound, "%s is not a crawl of %s", id, url)
+	}
+	if err := authorizeRead(ctx, r.owner); err != nil {
+		return result{}, err
+	}
+
+	return r, nil
 }
 
-func (s *Service) getProtoTrees() []*pb.SiteTree {
+// readableResults returns the results that the caller may read, oldest first.
+func readableResults(ctx context.Context, results []result) []result {
+	readable := make([]result, 0, len(results))
+	for _, r := range results {
+		if canRead(ctx, r.owner) {
+			readable = append(readable, r)
+		}
+	}
+
+	return readable
+}
+
+// getTreeOwners returns the owners of each of the results for the given URL.
+func (s *Service) getTreeOwners(url string) []string {
+	s.treesLock.RLock()
+	defer s.treesLock.RUnlock()
+
+	owners := make([]string, 0, len(s.trees[url]))
+	for _, r := range s.trees[url] {
+		owners = append(owners, r.owner)
+	}
+
+	return owners
+}
+
+// getProtoTrees returns the most recent site tree that the caller may read for
+// each URL, along with its pages if includePages is true.
+func (s *Service) getProtoTrees(ctx context.Context, includePages bool) []*pb.SiteTree {
 	s.treesLock.RLock()
 	defer s.treesLock.RUnlock()
 
 	trees := make([]*pb.SiteTree, 0, len(s.trees))
-	for site, tree := range s.trees {
-		trees = append(trees, &pb.SiteTree{
-			Url:  site,
-			Tree: treeToProto(tree),
-		})
+	for site, results := range s.trees {
+		readable := readableResults(ctx, results)
+		if len(readable) == 0 {
+			continue
+		}
+		latest := readable[len(readable)-1]
+		tree := &pb.SiteTree{
+			Url:   site,
+			Tree:  markNoIndex(treeToProto(latest.tree), latest.pages),
+			Id:    latest.id,
+			Stats: statsToProto(latest.pages),
+		}
+		tree.Stats.Skipped = int32(len(latest.skipped))
+
+		if includePages {
+			tree.Pages = pagesToProto(latest.pages)
+			tree.Skipped = skippedToProto(latest.skipped)
+		}
+
+		trees = append(trees, tree)
 	}
 
 	return trees
@@ -162,6 +519,41 @@ func treeToProto(t site.Tree) *pb.Tree {
 	}
 }
 
+// markNoIndex marks the nodes of the tree whose pages ask not to be indexed.
+// The tree's nodes are path segments, so the pages are matched by their path
+// without its leading and trailing slashes. Pages with a query are one of many
+// pages at their node, so they don't mark it.
+func markNoIndex(tree *pb.Tree, pages map[string]*site.Page) *pb.Tree {
+	noIndex := map[string]bool{}
+	for _, p := range pages {
+		if !p.NoIndex {
+			continue
+		}
+		if u, err := url.Parse(p.URL); err == nil && len(u.RawQuery) == 0 {
+			noIndex[strings.Trim(u.Path, "/")] = true
+		}
+	}
+
+	if len(noIndex) == 0 {
+		return tree
+	}
+
+	var mark func(t *pb.Tree, path string)
+	mark = func(t *pb.Tree, path string) {
+		t.Noindex = noIndex[path]
+		for _, child := range t.GetChildren() {
+			if len(path) == 0 {
+				mark(child, child.GetName())
+			} else {
+				mark(child, path+"/"+child.GetName())
+			}
+		}
+	}
+	mark(tree, "")
+
+	return tree
+}
+
 func treesToProto(trees []*site.Tree) []*pb.Tree {
 	if len(trees) == 0 {
 		return []*pb.Tree{}
@@ -174,3 +566,89 @@ func treesToProto(trees []*site.Tree) []*pb.Tree {
 
 	return protoTrees
 }
+
+// statsToProto summarizes the pages fetched by a crawl.
+func statsToProto(pages map[string]*site.Page) *pb.CrawlStats {
+	stats := &pb.CrawlStats{Pages: int32(len(pages))}
+	for _, p := range pages {
+		if p.NotModified {
+			stats.Unchanged++
+		}
+		if p.Status == 0 {
+			stats.Failed++
+		}
+	}
+
+	return stats
+}
+
+func skippedToProto(skipped []site.SkippedURL) []*pb.SkippedURL {
+	protoSkipped := make([]*pb.SkippedURL, 0, len(skipped))
+	for _, s := range skipped {
+		protoSkipped = append(protoSkipped, &pb.SkippedURL{Url: s.URL, Reason: s.Reason})
+	}
+
+	return protoSkipped
+}
+
+// pagesToProto converts the pages to protobufs sorted by their URL.
+func pagesToProto(pages map[string]*site.Page) []*pb.Page {
+	protoPages := make([]*pb.Page, 0, len(pages))
+	for _, p := range pages {
+		protoPages = append(protoPages, pageToProto(p))
+	}
+
+	sort.Slice(protoPages, func(i, j int) bool { return protoPages[i].GetUrl() < protoPages[j].GetUrl() })
+
+	return protoPages
+}
+
+func pageToProto(p *site.Page) *pb.Page {
+	if p == nil {
+		return nil
+	}
+
+	// The fetch times are always valid so we can ignore the error.
+	fetchedAt, _ := ptypes.TimestampProto(p.FetchedAt)
+	return &pb.Page{
+		Url:           p.URL,
+		Status:        int32(p.Status),
+		Error:         p.Error,
+		ContentType:   p.ContentType,
+		Title:         p.Title,
+		ContentHash:   p.ContentHash,
+		Links:         p.Links,
+		FetchedAt:     fetchedAt,
+		Etag:          p.ETag,
+		LastModified:  p.LastModified,
+		NotModified:   p.NotModified,
+		Size:          p.Size,
+		ExternalLinks: p.ExternalLinks,
+		Description:   p.Description,
+		H1S:           p.H1s,
+		Canonical:     p.Canonical,
+		Noindex:       p.NoIndex,
+		Words:         int32(p.Words),
+		Nofollow:      p.NoFollow,
+		NofollowLinks: p.NofollowLinks,
+		Hreflangs:     hreflangsToProto(p.Hreflangs),
+		TextHash:      p.TextHash,
+		Simhash:       p.SimHash,
+		DuplicateOf:   p.DuplicateOf,
+
+		StructuredData: structuredDataToProto(p.StructuredData),
+	}
+}
+
+func hreflangsToProto(hreflangs []site.Hreflang) []*pb.Hreflang {
+	if len(hreflangs) == 0 {
+		return nil
+	}
+
+	protoHreflangs := make([]*pb.Hreflang, 0, len(hreflangs))
+	for _, h := range hreflangs {
+		protoHreflangs = append(protoHreflangs, &pb.Hreflang{Lang: h.Lang, Url: h.URL})
+	}
+
+	return protoHreflangs
+}
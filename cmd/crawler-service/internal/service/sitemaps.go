@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	pb "github.com/wrrn/crawler/pkg/crawler"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SitemapReport compares the pages listed in a site's sitemaps with the pages
+// that were reachable by following links.
+func (s *Service) SitemapReport(ctx context.Context, req *pb.SitemapReportRequest) (*pb.SitemapReportResponse, error) {
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !r.sitemapsRead:
+		return nil, status.Errorf(codes.FailedPrecondition, "Crawl %s did not read the sitemaps, start the crawl with sitemaps enabled", r.id)
+	case r.sitemapPages == 0:
+		return nil, status.Errorf(codes.NotFound, "Crawl %s did not find any pages in the sitemaps", r.id)
+	case len(r.sitemapURLs) == 0:
+		return nil, status.Errorf(codes.NotFound, "None of the %d pages in the sitemaps of crawl %s are on the same host", r.sitemapPages, r.id)
+	}
+
+	// The start of the crawl isn't linked to by anything, but it was reachable.
+	start, _ := parseURL(req.GetUrl())
+	linked := map[string]bool{rootPath(start.Path): true}
+	for _, page := range r.pages {
+		for _, link := range page.Links {
+			if u, err := url.Parse(link); err == nil {
+				linked[rootPath(u.Path)] = true
+			}
+		}
+	}
+
+	resp := &pb.SitemapReportResponse{Id: r.id, SitemapUrls: int32(len(r.sitemapURLs))}
+	inSitemap := map[string]bool{}
+	for _, raw := range r.sitemapURLs {
+		u, err := url.Parse(raw)
+		if err != nil || inSitemap[rootPath(u.Path)] {
+			continue
+		}
+
+		path := rootPath(u.Path)
+		inSitemap[path] = true
+		if !linked[path] {
+			resp.SitemapOnly = append(resp.SitemapOnly, raw)
+		}
+	}
+
+	// Sitemaps are compared by path, so only one of the pages with the same
+	// path and different queries is reported.
+	reported := map[string]bool{}
+	for _, page := range r.pages {
+		u, err := url.Parse(page.URL)
+		if err != nil {
+			continue
+		}
+		path := rootPath(u.Path)
+
+		// Only report the pages that exist, a broken link missing from the
+		// sitemap is expected.
+		if linked[path] && !inSitemap[path] && !reported[path] && page.Status == 200 && strings.Contains(page.ContentType, "text/html") {
+			reported[path] = true
+			resp.LinksOnly = append(resp.LinksOnly, page.URL)
+		}
+	}
+
+	sort.Strings(resp.SitemapOnly)
+	sort.Strings(resp.LinksOnly)
+
+	return resp, nil
+}
+
+// rootPath treats the empty path as "/", since both are the root of the site.
+func rootPath(path string) string {
+	if len(path) == 0 {
+		return "/"
+	}
+
+	return path
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+	pb "github.com/wrrn/crawler/pkg/crawler"
+)
+
+// StructuredData returns the structured data of each page of a crawl that has
+// some, optionally only the pages with data of a given type.
+func (s *Service) StructuredData(ctx context.Context, req *pb.StructuredDataRequest) (*pb.StructuredDataResponse, error) {
+	r, err := s.getResult(ctx, req.GetUrl(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StructuredDataResponse{Id: r.id}
+	for _, p := range sortedPages(r.pages) {
+		if p.StructuredData.Empty() || (len(req.GetType()) > 0 && !hasType(p.StructuredData, req.GetType())) {
+			continue
+		}
+
+		resp.Pages = append(resp.Pages, &pb.PageStructuredData{Url: p.URL, Data: structuredDataToProto(p.StructuredData)})
+	}
+
+	return resp, nil
+}
+
+// sortedPages returns the pages sorted by their URL.
+func sortedPages(pages map[string]*site.Page) []*site.Page {
+	sorted := make([]*site.Page, 0, len(pages))
+	for _, p := range pages {
+		sorted = append(sorted, p)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].URL < sorted[j].URL })
+
+	return sorted
+}
+
+// hasType returns true if any of the JSON-LD objects or Microdata items have
+// the type. A type without a vocabulary, like "Product", matches the type in
+// any vocabulary, like "https://schema.org/Product".
+func hasType(data *site.StructuredData, typ string) bool {
+	matches := func(t string) bool {
+		return t == typ || (!strings.Contains(typ, "/") && strings.HasSuffix(t, "/"+typ))
+	}
+
+	for _, ld := range data.JSONLD {
+		for _, t := range ld.Types {
+			if matches(t) {
+				return true
+			}
+		}
+	}
+
+	var itemHasType func(item site.MicrodataItem) bool
+	itemHasType = func(item site.MicrodataItem) bool {
+		for _, t := range item.Types {
+			if matches(t) {
+				return true
+			}
+		}
+		for _, p := range item.Properties {
+			if p.Item != nil && itemHasType(*p.Item) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, item := range data.Microdata {
+		if itemHasType(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exportJSONL writes the structured data of each page that has some as a line
+// of JSON, to a single file named after the site's host. Valid JSON-LD is
+// included as it is, and Microdata items use the format of the Microdata
+// spec's JSON conversion.
+func exportJSONL(r result, rawURL string) ([]*pb.ExportFile, error) {
+	root, err := parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, p := range sortedPages(r.pages) {
+		if p.StructuredData.Empty() {
+			continue
+		}
+
+		if err := enc.Encode(structuredDataLine(p)); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*pb.ExportFile{{Name: root.Hostname() + ".jsonl", Content: buf.Bytes()}}, nil
+}
+
+// jsonLine is a single line of the JSON Lines export.
+type jsonLine struct {
+	URL          string            `json:"url"`
+	JSONLD       []json.RawMessage `json:"json_ld,omitempty"`
+	JSONLDErrors []jsonLDError     `json:"json_ld_errors,omitempty"`
+	Microdata    []microdataJSON   `json:"microdata,omitempty"`
+	OpenGraph    []metaJSON        `json:"open_graph,omitempty"`
+	Twitter      []metaJSON        `json:"twitter,omitempty"`
+}
+
+type jsonLDError struct {
+	Raw   string `json:"raw"`
+	Error string `json:"error"`
+}
+
+type microdataJSON struct {
+	Type       []string                 `json:"type,omitempty"`
+	ID         string                   `json:"id,omitempty"`
+	Properties map[string][]interface{} `json:"properties"`
+}
+
+type metaJSON struct {
+	Property string `json:"property"`
+	Content  string `json:"content"`
+}
+
+func structuredDataLine(p *site.Page) jsonLine {
+	data := p.StructuredData
+	line := jsonLine{URL: p.URL}
+	for _, ld := range data.JSONLD {
+		if len(ld.Error) > 0 {
+			line.JSONLDErrors = append(line.JSONLDErrors, jsonLDError{Raw: ld.Raw, Error: ld.Error})
+			continue
+		}
+
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, []byte(ld.Raw)); err == nil {
+			line.JSONLD = append(line.JSONLD, compact.Bytes())
+		}
+	}
+
+	for _, item := range data.Microdata {
+		line.Microdata = append(line.Microdata, microdataToJSON(item))
+	}
+
+	for _, m := range data.OpenGraph {
+		line.OpenGraph = append(line.OpenGraph, metaJSON{Property: m.Name, Content: m.Content})
+	}
+
+	for _, m := range data.Twitter {
+		line.Twitter = append(line.Twitter, metaJSON{Property: m.Name, Content: m.Content})
+	}
+
+	return line
+}
+
+func microdataToJSON(item site.MicrodataItem) microdataJSON {
+	out := microdataJSON{Type: item.Types, ID: item.ID, Properties: map[string][]interface{}{}}
+	for _, p := range item.Properties {
+		var val interface{} = p.Value
+		if p.Item != nil {
+			val = microdataToJSON(*p.Item)
+		}
+		out.Properties[p.Name] = append(out.Properties[p.Name], val)
+	}
+
+	return out
+}
+
+func structuredDataToProto(data *site.StructuredData) *pb.StructuredData {
+	if data == nil {
+		return nil
+	}
+
+	out := &pb.StructuredData{
+		OpenGraph: metaPropertiesToProto(data.OpenGraph),
+		Twitter:   metaPropertiesToProto(data.Twitter),
+	}
+
+	for _, ld := range data.JSONLD {
+		out.JsonLd = append(out.JsonLd, &pb.JsonLd{Raw: ld.Raw, Error: ld.Error, Types: ld.Types})
+	}
+
+	for _, item := range data.Microdata {
+		out.Microdata = append(out.Microdata, microdataItemToProto(item))
+	}
+
+	return out
+}
+
+func microdataItemToProto(item site.MicrodataItem) *pb.MicrodataItem {
+	out := &pb.MicrodataItem{Types: item.Types, Id: item.ID}
+	for _, p := range item.Properties {
+		prop := &pb.MicrodataProperty{Name: p.Name, Value: p.Value}
+		if p.Item != nil {
+			prop.Item = microdataItemToProto(*p.Item)
+		}
+		out.Properties = append(out.Properties, prop)
+	}
+
+	return out
+}
+
+func metaPropertiesToProto(props []site.MetaProperty) []*pb.MetaProperty {
+	var out []*pb.MetaProperty
+	for _, p := range props {
+		out = append(out, &pb.MetaProperty{Name: p.Name, Content: p.Content})
+	}
+
+	return out
+}
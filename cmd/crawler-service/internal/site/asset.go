@@ -0,0 +1,12 @@
+package site
+
+// Asset is a resource used by a page, like an image or a script.
+type Asset struct {
+	URL string
+
+	// Type is one of image, script, stylesheet or media.
+	Type string
+
+	// ThirdParty is true if the asset isn't on the same host as the page.
+	ThirdParty bool
+}
@@ -0,0 +1,20 @@
+package site
+
+// URLCheck is the result of requesting a URL, like an asset or an external
+// link, to find its status and size without crawling it.
+type URLCheck struct {
+	// Status is the HTTP status code of the response. It is 0 if the request
+	// failed, in which case Error describes why.
+	Status int
+	Error  string
+
+	ContentType string
+
+	// Size is the size of the response body in bytes, or -1 if it isn't known.
+	Size int64
+}
+
+// Broken returns true if the URL couldn't be fetched.
+func (c *URLCheck) Broken() bool {
+	return c.Status == 0 || c.Status >= 400
+}
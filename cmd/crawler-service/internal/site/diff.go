@@ -0,0 +1,74 @@
+package site
+
+import "sort"
+
+// ChangeType describes how a page differs between two crawls.
+type ChangeType int
+
+const (
+	// Unchanged pages are in both crawls and have not changed.
+	Unchanged ChangeType = iota
+
+	// Added pages are only in the newer crawl.
+	Added
+
+	// Removed pages are only in the older crawl.
+	Removed
+
+	// Changed pages are in both crawls but their status, title or content
+	// changed.
+	Changed
+)
+
+// Change describes how the page at a path differs between two crawls.
+type Change struct {
+	Path string
+	Type ChangeType
+
+	// From and To are the page in the older and newer crawl. From is nil for
+	// added pages and To is nil for removed pages.
+	From, To *Page
+
+	// Fields are the fields that changed, any of "status", "title" and
+	// "content".
+	Fields []string
+}
+
+// Diff compares the pages of two crawls, keyed by their path, and returns a
+// change for every path in either crawl sorted by path.
+func Diff(from, to map[string]*Page) []Change {
+	changes := make([]Change, 0, len(to))
+	for path, toPage := range to {
+		fromPage, found := from[path]
+		if !found {
+			changes = append(changes, Change{Path: path, Type: Added, To: toPage})
+			continue
+		}
+
+		change := Change{Path: path, Type: Unchanged, From: fromPage, To: toPage}
+		if fromPage.Status != toPage.Status {
+			change.Fields = append(change.Fields, "status")
+		}
+		if fromPage.Title != toPage.Title {
+			change.Fields = append(change.Fields, "title")
+		}
+		if fromPage.ContentHash != toPage.ContentHash {
+			change.Fields = append(change.Fields, "content")
+		}
+
+		if len(change.Fields) > 0 {
+			change.Type = Changed
+		}
+		changes = append(changes, change)
+	}
+
+	for path, fromPage := range from {
+		if _, found := to[path]; !found {
+			changes = append(changes, Change{Path: path, Type: Removed, From: fromPage})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+
+	return changes
+}
@@ -0,0 +1,7 @@
+package site
+
+// Field is the values extracted from a page by a named rule.
+type Field struct {
+	Name   string
+	Values []string
+}
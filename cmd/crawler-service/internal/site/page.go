@@ -0,0 +1,101 @@
+package site
+
+import "time"
+
+// Page is the metadata of a single page fetched during a crawl.
+type Page struct {
+	// URL is the URL that was requested, and FinalURL is the normalized URL of
+	// the response after following any redirects.
+	URL      string
+	FinalURL string
+
+	// Status is the HTTP status code of the response. It is 0 if the request
+	// failed, in which case Error describes why.
+	Status int
+	Error  string
+
+	ContentType string
+	Title       string
+
+	// Description is the page's meta description, and H1s are the text of its
+	// top level headings.
+	Description string
+	H1s         []string
+
+	// Canonical is the URL of the page's canonical link, if it has one.
+	Canonical string
+
+	// Hreflangs are the page's links to versions of itself in other
+	// languages.
+	Hreflangs []Hreflang
+
+	// NoIndex is true if the page asks not to be indexed, through either a
+	// robots meta tag or the X-Robots-Tag header.
+	NoIndex bool
+
+	// NoFollow is true if the page asks for its links not to be followed,
+	// through either a robots meta tag or the X-Robots-Tag header.
+	NoFollow bool
+
+	// Text is the page's visible text, and Words is the number of words in
+	// it.
+	Text  string
+	Words int
+
+	// TextHash is the hex encoded SHA-256 of the page's visible text, and
+	// SimHash is a fingerprint of the text that differs by only a few bits for
+	// pages with nearly the same text.
+	TextHash string
+	SimHash  uint64
+
+	// DuplicateOf is the URL of a page seen earlier in the crawl that has the
+	// same or nearly the same text, if the crawl looked for duplicates.
+	DuplicateOf string
+
+	// ContentHash is the hex encoded SHA-256 of the response body, and Size is
+	// its length in bytes.
+	ContentHash string
+	Size        int64
+
+	// Links are the URLs of the pages on the same site that the page links to.
+	Links []string
+
+	// NofollowLinks are the Links that the page only links to with
+	// rel="nofollow".
+	NofollowLinks []string
+
+	// ExternalLinks are the URLs on other sites that the page links to, each
+	// listed once.
+	ExternalLinks []string
+
+	// StructuredData is the page's JSON-LD, Microdata, OpenGraph and Twitter
+	// card metadata, or nil if it has none.
+	StructuredData *StructuredData
+
+	// Fields are the values extracted by the crawl's rules, and Extracted is
+	// true if any of the rules applied to the page.
+	Fields    []Field
+	Extracted bool
+
+	// Assets are the resources that the page uses, each listed once.
+	Assets []Asset
+
+	// ETag and LastModified are the validators sent by the server, which are
+	// used to make conditional requests when the site is crawled again.
+	ETag         string
+	LastModified string
+
+	// NotModified is true if the server responded to a conditional request
+	// with 304 Not Modified, in which case the rest of the page's metadata was
+	// copied from the previous crawl.
+	NotModified bool
+
+	FetchedAt time.Time
+}
+
+// Hreflang is a link from a page to a version of itself in the language given
+// by Lang, like "en-gb" or "x-default".
+type Hreflang struct {
+	Lang string
+	URL  string
+}
@@ -0,0 +1,8 @@
+package site
+
+// SkippedURL is a URL that a crawl found but didn't fetch because it looked
+// like a crawler trap. Reason is the heuristic that detected the trap.
+type SkippedURL struct {
+	URL    string
+	Reason string
+}
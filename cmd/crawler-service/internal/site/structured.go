@@ -0,0 +1,47 @@
+package site
+
+// StructuredData is the machine readable metadata embedded in a page.
+type StructuredData struct {
+	JSONLD    []JSONLD
+	Microdata []MicrodataItem
+
+	// OpenGraph are the page's og: meta tags, and Twitter are its twitter:
+	// meta tags, in the order they appear.
+	OpenGraph []MetaProperty
+	Twitter   []MetaProperty
+}
+
+// Empty returns true if the page has no structured data.
+func (d *StructuredData) Empty() bool {
+	return d == nil || len(d.JSONLD)+len(d.Microdata)+len(d.OpenGraph)+len(d.Twitter) == 0
+}
+
+// JSONLD is a JSON-LD script. Error describes why the script isn't valid JSON,
+// and is empty if it is. Types are the @type values of the script's top level
+// objects, including those in an @graph.
+type JSONLD struct {
+	Raw   string
+	Error string
+	Types []string
+}
+
+// MicrodataItem is a Microdata item, an element with an itemscope attribute.
+type MicrodataItem struct {
+	Types      []string
+	ID         string
+	Properties []MicrodataProperty
+}
+
+// MicrodataProperty is a property of a Microdata item. Item is set if the
+// property's value is another item, otherwise Value is its text value.
+type MicrodataProperty struct {
+	Name  string
+	Value string
+	Item  *MicrodataItem
+}
+
+// MetaProperty is a meta tag's property and content.
+type MetaProperty struct {
+	Name    string
+	Content string
+}
@@ -0,0 +1,42 @@
+package site
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NormalizeURL returns the URL with its scheme and host lower cased, an empty
+// path replaced by "/" and its fragment removed, so that different forms of
+// the same URL can be compared. URLs that can't be parsed are returned as they
+// are.
+func NormalizeURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if len(u.Path) == 0 && len(u.Opaque) == 0 {
+		u.Path = "/"
+	}
+	u.Fragment = ""
+
+	return u.String()
+}
+
+// ResponseURL returns the normalized URL that the page was served from, after
+// following any redirects. Pages without one, because their request failed or
+// they were fetched by an older crawl, use the URL that was requested.
+func (p *Page) ResponseURL() string {
+	if len(p.FinalURL) > 0 {
+		return p.FinalURL
+	}
+	return NormalizeURL(p.URL)
+}
+
+// CanonicalElsewhere returns true if the page has a canonical URL that isn't
+// the URL it was served from.
+func (p *Page) CanonicalElsewhere() bool {
+	return len(p.Canonical) > 0 && NormalizeURL(p.Canonical) != p.ResponseURL()
+}
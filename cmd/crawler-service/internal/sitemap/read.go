@@ -0,0 +1,183 @@
+package sitemap
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxSitemapSize is the largest uncompressed sitemap that is read. The
+	// sitemap protocol limits sitemaps to 50MB.
+	maxSitemapSize = 50 << 20
+
+	// maxSitemaps is the most sitemaps that are fetched for a site, including
+	// the sitemaps listed in sitemap indexes.
+	maxSitemaps = 100
+)
+
+// Fetcher performs the HTTP requests for fetching sitemaps. *http.Client
+// implements Fetcher.
+type Fetcher interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Discover returns the URLs of the sitemaps for the site at root. These are
+// the sitemaps listed by the Sitemap directives in the site's robots.txt, or
+// /sitemap.xml if robots.txt doesn't list any.
+func Discover(ctx context.Context, fetcher Fetcher, root *url.URL) []string {
+	robots := &url.URL{Scheme: root.Scheme, Host: root.Host, Path: "/robots.txt"}
+	if sitemaps, _ := robotsSitemaps(ctx, fetcher, robots.String()); len(sitemaps) > 0 {
+		return sitemaps
+	}
+
+	fallback := &url.URL{Scheme: root.Scheme, Host: root.Host, Path: "/sitemap.xml"}
+	return []string{fallback.String()}
+}
+
+// robotsSitemaps returns the URLs in the Sitemap directives of a robots.txt.
+func robotsSitemaps(ctx context.Context, fetcher Fetcher, robotsURL string) ([]string, error) {
+	body, err := get(ctx, fetcher, robotsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var sitemaps []string
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || !strings.EqualFold(strings.TrimSpace(parts[0]), "sitemap") {
+			continue
+		}
+
+		if loc := strings.TrimSpace(parts[1]); len(loc) > 0 {
+			sitemaps = append(sitemaps, loc)
+		}
+	}
+
+	return sitemaps, scanner.Err()
+}
+
+// document is either a sitemap (urlset) or a sitemap index (sitemapindex).
+type document struct {
+	XMLName  xml.Name
+	URLs     []location `xml:"url"`
+	Sitemaps []location `xml:"sitemap"`
+}
+
+type location struct {
+	Loc string `xml:"loc"`
+}
+
+// Read fetches the sitemaps and returns the URLs of the pages they list. Sitemap
+// indexes are followed, and gzipped sitemaps are decompressed. Sitemaps that
+// can't be fetched or parsed are skipped, and the errors returned.
+func Read(ctx context.Context, fetcher Fetcher, sitemaps []string) ([]string, []error) {
+	var (
+		pages   []string
+		errs    []error
+		queue   = append([]string(nil), sitemaps...)
+		fetched = map[string]bool{}
+	)
+
+	for len(queue) > 0 && len(fetched) < maxSitemaps {
+		sitemapURL := queue[0]
+		queue = queue[1:]
+		if fetched[sitemapURL] {
+			continue
+		}
+		fetched[sitemapURL] = true
+
+		doc, err := fetchDocument(ctx, fetcher, sitemapURL)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, u := range doc.URLs {
+			if loc := strings.TrimSpace(u.Loc); len(loc) > 0 {
+				pages = append(pages, loc)
+			}
+		}
+
+		for _, s := range doc.Sitemaps {
+			if loc := strings.TrimSpace(s.Loc); len(loc) > 0 {
+				queue = append(queue, loc)
+			}
+		}
+	}
+
+	return pages, errs
+}
+
+// fetchDocument fetches and parses a single sitemap or sitemap index.
+func fetchDocument(ctx context.Context, fetcher Fetcher, sitemapURL string) (*document, error) {
+	body, err := get(ctx, fetcher, sitemapURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers don't reliably set the content type or encoding for gzipped
+	// sitemaps, so look for the gzip magic number instead.
+	if len(body) > 2 && body[0] == 0x1f && body[1] == 0x8b {
+		gz, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress %s", sitemapURL)
+		}
+
+		body, err = ioutil.ReadAll(io.LimitReader(gz, maxSitemapSize))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress %s", sitemapURL)
+		}
+	}
+
+	var doc document
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", sitemapURL)
+	}
+
+	if doc.XMLName.Local != "urlset" && doc.XMLName.Local != "sitemapindex" {
+		return nil, errors.Errorf("%s is not a sitemap", sitemapURL)
+	}
+
+	return &doc, nil
+}
+
+// get fetches the body at the given URL.
+func get(ctx context.Context, fetcher Fetcher, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build request for %s", u)
+	}
+
+	resp, err := fetcher.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve %s", u)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to retrieve %s: %s", u, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSitemapSize))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", u)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,156 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+const (
+	// MaxURLs is the most URLs the sitemap protocol allows in one sitemap.
+	MaxURLs = 50000
+
+	// maxFileSize is the largest uncompressed sitemap the protocol allows.
+	maxFileSize = 50 << 20
+
+	namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+// URL is a page to list in a sitemap.
+type URL struct {
+	Loc string
+
+	// LastMod is when the page was last modified. It is left out of the sitemap
+	// if it is zero.
+	LastMod time.Time
+}
+
+// File is a generated sitemap or sitemap index.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Generate creates a sitemap named sitemap.xml listing the URLs. If the URLs
+// don't fit in a single sitemap they are split across sitemap-1.xml,
+// sitemap-2.xml, ... and sitemap.xml is a sitemap index listing each of them.
+// baseURL is the URL that the files will be served from, it is used for the
+// locations in the index.
+func Generate(urls []URL, baseURL string) ([]File, error) {
+	sitemaps, err := split(urls)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sitemaps) == 1 {
+		return []File{{Name: "sitemap.xml", Content: sitemaps[0]}}, nil
+	}
+
+	files := make([]File, 0, len(sitemaps)+1)
+	index := make([]indexEntry, 0, len(sitemaps))
+	now := time.Now().UTC().Format(time.RFC3339)
+	for i, content := range sitemaps {
+		name := fmt.Sprintf("sitemap-%d.xml", i+1)
+		files = append(files, File{Name: name, Content: content})
+		index = append(index, indexEntry{Loc: baseURL + name, LastMod: now})
+	}
+
+	content, err := marshal(sitemapIndex{Xmlns: namespace, Sitemaps: index})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]File{{Name: "sitemap.xml", Content: content}}, files...), nil
+}
+
+type urlset struct {
+	XMLName xml.Name   `xml:"urlset"`
+	Xmlns   string     `xml:"xmlns,attr"`
+	URLs    []urlEntry `xml:"url"`
+}
+
+type urlEntry struct {
+	XMLName xml.Name `xml:"url"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	Sitemaps []indexEntry `xml:"sitemap"`
+}
+
+type indexEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// split marshals the URLs into as many sitemaps as are needed to stay within
+// the protocol's limits on the number of URLs and size of a sitemap.
+func split(urls []URL) ([][]byte, error) {
+	var (
+		sitemaps [][]byte
+		entries  []urlEntry
+		size     int
+	)
+
+	// The size of everything in a sitemap other than its entries, and the most
+	// whitespace that indenting adds to an entry.
+	overhead := len(xml.Header) + len(`<urlset xmlns="`+namespace+`"></urlset>`) + 1
+	const indent = 32
+
+	flush := func() error {
+		content, err := marshal(urlset{Xmlns: namespace, URLs: entries})
+		if err != nil {
+			return err
+		}
+
+		sitemaps = append(sitemaps, content)
+		entries, size = nil, 0
+		return nil
+	}
+
+	for _, u := range urls {
+		entry := urlEntry{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			entry.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+
+		b, err := xml.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entries) == MaxURLs || (len(entries) > 0 && overhead+size+len(b)+indent > maxFileSize) {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+
+		entries = append(entries, entry)
+		size += len(b) + indent
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return sitemaps, nil
+}
+
+// marshal encodes the sitemap document with an XML declaration.
+func marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
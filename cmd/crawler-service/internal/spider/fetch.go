@@ -0,0 +1,320 @@
+package spider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/document"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/fingerprint"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+)
+
+// maxBodySize is the most of a response body that is read, so that a huge
+// response can't exhaust our memory.
+const maxBodySize = 10 << 20
+
+// fetched is the result of fetching a page.
+type fetched struct {
+	page *site.Page
+
+	// links are the URLs of the pages on the same site that the page links to.
+	links []*url.URL
+
+	// body is the body of the response, and doc is the parsed body if the
+	// page is HTML. Both are nil if the page wasn't modified.
+	body []byte
+	doc  *document.Document
+}
+
+// fetch requests the page at the given url and returns its metadata along with
+// the URLs of the pages on the same site that it links to. A page is returned
+// even if the request fails, so that the failure is recorded.
+//
+// If prev, the page from a previous crawl, is not nil then a conditional
+// request is made using its validators, and if the page hasn't been modified
+// prev's metadata and links are reused.
+func fetch(ctx context.Context, fetcher Fetcher, u *url.URL, prev *site.Page) (*fetched, error) {
+	page := &site.Page{URL: u.String(), FetchedAt: time.Now()}
+	f := &fetched{page: page}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build request for %s", u)
+	}
+
+	// Set the accept header so we have chance of the server not sending back some huge binary.
+	req.Header.Set("accept", "text/html")
+
+	if prev != nil && len(prev.ETag) > 0 {
+		req.Header.Set("if-none-match", prev.ETag)
+	}
+	if prev != nil && len(prev.LastModified) > 0 {
+		req.Header.Set("if-modified-since", prev.LastModified)
+	}
+
+	resp, err := fetcher.Do(req)
+	if err != nil {
+		page.Error = err.Error()
+		return f, errors.Wrapf(err, "failed to retrieve the body from %s", u)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && prev != nil {
+		return notModified(u, prev)
+	}
+
+	page.Status = resp.StatusCode
+	page.FinalURL = site.NormalizeURL(resp.Request.URL.String())
+	page.ContentType = resp.Header.Get("content-type")
+	page.ETag = resp.Header.Get("etag")
+	page.LastModified = resp.Header.Get("last-modified")
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		page.Error = err.Error()
+		return f, errors.Wrapf(err, "failed to read the body from %s", u)
+	}
+	f.body = body
+
+	sum := sha256.Sum256(body)
+	page.ContentHash = hex.EncodeToString(sum[:])
+	page.Size = int64(len(body))
+
+	// There isn't anything else for us to do with a resource that isn't a HTML page.
+	if !strings.Contains(page.ContentType, "text/html") {
+		return f, nil
+	}
+
+	doc, err := document.Parse(bytes.NewReader(body), u)
+	if err != nil {
+		return f, errors.Wrapf(err, "failed to parse the html from %s", u)
+	}
+	f.doc = doc
+
+	page.Title = doc.Title()
+	page.Description = doc.Description()
+	page.H1s = doc.Headings(1)
+	text := doc.Text()
+	page.Text = text
+	page.Words = len(strings.Fields(text))
+	page.TextHash = fingerprint.Hash(text)
+	page.SimHash = fingerprint.SimHash(text)
+	robots := append(headerRobots(resp.Header), doc.Robots()...)
+	page.NoIndex = hasDirective(robots, "noindex")
+	page.NoFollow = hasDirective(robots, "nofollow")
+	if canonical, ok := doc.Canonical(); ok {
+		canonical.Fragment = ""
+		page.Canonical = canonical.String()
+	}
+	for _, alt := range doc.Alternates() {
+		alt.URL.Fragment = ""
+		page.Hreflangs = append(page.Hreflangs, site.Hreflang{Lang: alt.Lang, URL: alt.URL.String()})
+	}
+	page.StructuredData = structuredData(doc)
+	page.Assets = pageAssets(u, doc)
+
+	seenExternal := map[string]bool{}
+
+	// A link is only nofollow if every anchor to it is.
+	followed := map[string]bool{}
+	for _, anchor := range doc.Anchors() {
+		link := anchor.URL
+
+		// We only crawl links to the current host, but keep track of the
+		// others.
+		if link.Hostname() != u.Hostname() {
+			if link.Scheme != "http" && link.Scheme != "https" {
+				continue
+			}
+
+			link.Fragment = ""
+			if raw := link.String(); !seenExternal[raw] {
+				seenExternal[raw] = true
+				page.ExternalLinks = append(page.ExternalLinks, raw)
+			}
+			continue
+		}
+
+		f.links = append(f.links, link)
+		page.Links = append(page.Links, link.String())
+		if !anchor.Nofollow {
+			followed[link.String()] = true
+		}
+	}
+
+	listed := map[string]bool{}
+	for _, raw := range page.Links {
+		if !followed[raw] && !listed[raw] {
+			listed[raw] = true
+			page.NofollowLinks = append(page.NofollowLinks, raw)
+		}
+	}
+
+	return f, nil
+}
+
+// headerRobots returns the robots directives in the response's X-Robots-Tag
+// headers. Headers aimed at a particular crawler, like "googlebot: noindex",
+// are ignored.
+func headerRobots(h http.Header) []string {
+	var directives []string
+	for _, val := range h.Values("x-robots-tag") {
+		if i := strings.Index(val, ":"); i > 0 && !valuedDirectives[strings.ToLower(strings.TrimSpace(val[:i]))] {
+			continue
+		}
+		directives = append(directives, document.ParseRobots(val)...)
+	}
+
+	return directives
+}
+
+// valuedDirectives are the robots directives that take a value after a colon,
+// so that they aren't mistaken for the name of a crawler.
+var valuedDirectives = map[string]bool{
+	"unavailable_after": true,
+	"max-snippet":       true,
+	"max-image-preview": true,
+	"max-video-preview": true,
+}
+
+// hasDirective returns true if the robots directives include the given
+// directive, either by name or through "none", which means both noindex and
+// nofollow.
+func hasDirective(directives []string, directive string) bool {
+	for _, d := range directives {
+		if d == directive || d == "none" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pageAssets returns the assets that the document uses, without duplicates.
+func pageAssets(u *url.URL, doc *document.Document) []site.Asset {
+	var assets []site.Asset
+	seen := map[string]bool{}
+	for _, a := range doc.Assets() {
+		if a.URL.Scheme != "http" && a.URL.Scheme != "https" {
+			continue
+		}
+
+		// Fragments don't change what is downloaded.
+		a.URL.Fragment = ""
+		raw := a.URL.String()
+		if seen[raw] {
+			continue
+		}
+		seen[raw] = true
+
+		assets = append(assets, site.Asset{
+			URL:        raw,
+			Type:       string(a.Type),
+			ThirdParty: a.URL.Hostname() != u.Hostname(),
+		})
+	}
+
+	return assets
+}
+
+// checkURL makes a HEAD request for the URL to find its status and size.
+// Servers that don't support HEAD are sent a GET request instead, and the size
+// of the body is counted up to maxBodySize.
+func checkURL(ctx context.Context, fetcher Fetcher, raw string) *site.URLCheck {
+	check := &site.URLCheck{Size: -1}
+
+	method := http.MethodHead
+	resp, err := checkRequest(ctx, fetcher, method, raw)
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented) {
+		resp.Body.Close()
+		method = http.MethodGet
+		resp, err = checkRequest(ctx, fetcher, method, raw)
+	}
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	defer resp.Body.Close()
+
+	if method == http.MethodGet {
+		if check.Size, err = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxBodySize)); err != nil {
+			check.Error = err.Error()
+			return check
+		}
+	}
+
+	check.Status = resp.StatusCode
+	check.ContentType = resp.Header.Get("content-type")
+	if check.Size < 0 {
+		check.Size = resp.ContentLength
+	}
+
+	return check
+}
+
+func checkRequest(ctx context.Context, fetcher Fetcher, method, raw string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, raw, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build request for %s", raw)
+	}
+
+	return fetcher.Do(req)
+}
+
+// fetchAsset downloads an asset, such as an image or stylesheet, and returns its
+// content type and body.
+func fetchAsset(ctx context.Context, fetcher Fetcher, u *url.URL) (string, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to build request for %s", u)
+	}
+
+	resp, err := fetcher.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, errors.Errorf("%s responded with %s", u, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxBodySize+1))
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to read the body from %s", u)
+	}
+
+	if len(body) > maxBodySize {
+		return "", nil, errors.Errorf("%s is larger than %d bytes", u, maxBodySize)
+	}
+
+	return resp.Header.Get("content-type"), body, nil
+}
+
+// notModified returns a copy of the previous crawl's page for a page that
+// hasn't been modified, along with the same-site URLs it linked to.
+func notModified(u *url.URL, prev *site.Page) (*fetched, error) {
+	page := *prev
+	page.NotModified = true
+	page.FetchedAt = time.Now()
+
+	links := make([]*url.URL, 0, len(prev.Links))
+	for _, raw := range prev.Links {
+		link, err := url.Parse(raw)
+		if err != nil || link.Hostname() != u.Hostname() {
+			continue
+		}
+		links = append(links, link)
+	}
+
+	return &fetched{page: &page, links: links}, nil
+}
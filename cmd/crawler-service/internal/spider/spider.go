@@ -5,21 +5,199 @@ import (
 	"log"
 	"net/http"
 	"net/url"
-	"strings"
+	"sort"
 	"sync"
+	"time"
 
-	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/document"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/extract"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/fingerprint"
 	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
-	"golang.org/x/net/html"
-	"golang.org/x/net/html/atom"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/sitemap"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/trap"
 )
 
+// DefaultWorkers is the number of pages a spider crawls at once if it isn't
+// given another limit.
+const DefaultWorkers = 32
+
 // New creates a new spider to crawl a site and build a site tree. Call it's
 // Crawl() method to start the crawling.
-func New() *Spider {
-	return &Spider{
-		stop: make(chan struct{}),
-		wg:   &sync.WaitGroup{},
+func New(opts ...Option) *Spider {
+	s := &Spider{
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		wg:      &sync.WaitGroup{},
+		fetcher: http.DefaultClient,
+		pages:   map[string]*site.Page{},
+		workers: DefaultWorkers,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Fetcher performs the HTTP requests made by a spider. *http.Client implements
+// Fetcher.
+type Fetcher interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Limiter limits the number of requests that are in flight at once.
+type Limiter interface {
+	// Acquire blocks until a request may be made or the context is done.
+	Acquire(ctx context.Context) error
+
+	// Release signals that a request acquired with Acquire has finished.
+	Release()
+}
+
+// Mirror saves local copies of the pages and assets that a spider fetches.
+// *mirror.Mirror implements Mirror.
+type Mirror interface {
+	// SavePage saves an HTML page and returns the assets that it uses.
+	SavePage(u *url.URL, doc *document.Document) ([]*url.URL, error)
+
+	// SaveAsset saves anything that isn't an HTML page, and returns any
+	// assets that it uses in turn.
+	SaveAsset(u *url.URL, contentType string, body []byte) ([]*url.URL, error)
+}
+
+// NofollowPolicy decides which nofollow directives a spider respects when it
+// follows links.
+type NofollowPolicy int
+
+const (
+	// NofollowIgnore follows every link.
+	NofollowIgnore NofollowPolicy = iota
+
+	// NofollowPages doesn't follow the links on pages whose robots meta tag or
+	// X-Robots-Tag header says nofollow.
+	NofollowPages
+
+	// NofollowAll also doesn't follow links with rel="nofollow".
+	NofollowAll
+)
+
+// Option configures optional behaviour of a Spider.
+type Option func(*Spider)
+
+// WithFetcher makes the spider use f for its requests instead of
+// http.DefaultClient.
+func WithFetcher(f Fetcher) Option {
+	return func(s *Spider) {
+		s.fetcher = f
+	}
+}
+
+// WithLimiter makes the spider acquire a slot from l before each request.
+func WithLimiter(l Limiter) Option {
+	return func(s *Spider) {
+		s.limiter = l
+	}
+}
+
+// WithWorkers limits the number of pages the spider crawls at once to n. A
+// value <= 0 uses DefaultWorkers.
+func WithWorkers(n int) Option {
+	return func(s *Spider) {
+		if n <= 0 {
+			n = DefaultWorkers
+		}
+		s.workers = n
+	}
+}
+
+// WithPrevious makes the spider use the pages from a previous crawl of the same
+// site, keyed by their path and query, to make conditional requests. Pages that haven't
+// been modified aren't downloaded again.
+func WithPrevious(pages map[string]*site.Page) Option {
+	return func(s *Spider) {
+		s.previous = pages
+	}
+}
+
+// WithSitemaps makes the spider seed the crawl with the pages listed in the
+// site's sitemaps, as well as following links.
+func WithSitemaps() Option {
+	return func(s *Spider) {
+		s.useSitemaps = true
+	}
+}
+
+// WithMirror makes the spider save every page it fetches, along with the
+// assets they use, to m. Conditional requests aren't made, since the pages
+// have to be downloaded to be saved.
+func WithMirror(m Mirror) Option {
+	return func(s *Spider) {
+		s.mirror = m
+	}
+}
+
+// WithAssetChecks makes the spider request every asset used by the pages it
+// fetches, to find their status and size.
+func WithAssetChecks() Option {
+	return func(s *Spider) {
+		s.checkAssets = true
+		s.assetChecks = map[string]*site.URLCheck{}
+	}
+}
+
+// WithExternalLinkChecks makes the spider request every link to another site
+// once it has finished crawling, without crawling them any further. The
+// requests are made one at a time, at most one every interval.
+func WithExternalLinkChecks(interval time.Duration) Option {
+	return func(s *Spider) {
+		s.externalInterval = interval
+		s.externalChecks = map[string]*site.URLCheck{}
+	}
+}
+
+// WithNofollowPolicy makes the spider respect the nofollow directives given by
+// p. By default every link is followed.
+func WithNofollowPolicy(p NofollowPolicy) Option {
+	return func(s *Spider) {
+		s.nofollow = p
+	}
+}
+
+// WithCanonicalTree makes the spider build its site tree from the pages'
+// canonical URLs, so that duplicates of a page on the same site are collapsed
+// onto the page their canonical link points to.
+func WithCanonicalTree() Option {
+	return func(s *Spider) {
+		s.canonicalTree = true
+	}
+}
+
+// WithDuplicateSkipping makes the spider look for pages whose text is the same
+// as, or differs by at most maxDistance bits of SimHash from, a page it has
+// already fetched, and not follow their links.
+func WithDuplicateSkipping(maxDistance int) Option {
+	return func(s *Spider) {
+		s.skipDuplicates = true
+		s.maxDistance = maxDistance
+	}
+}
+
+// WithTrapDetection makes the spider skip the URLs that its heuristics detect
+// as crawler traps, using the thresholds in opts.
+func WithTrapDetection(opts trap.Options) Option {
+	return func(s *Spider) {
+		s.traps = trap.NewDetector(opts)
+		s.skipped = []site.SkippedURL{}
+	}
+}
+
+// WithRules makes the spider run the extraction rules on every HTML page they
+// apply to. Conditional requests aren't made, since the pages have to be
+// downloaded for the rules to be run.
+func WithRules(rules []*extract.Rule) Option {
+	return func(s *Spider) {
+		s.rules = rules
 	}
 }
 
@@ -28,27 +206,118 @@ type Spider struct {
 	// stop is closed to notify all the workers to stop crawling.
 	stop chan struct{}
 
+	// done is closed once Crawl has finished.
+	done chan struct{}
+
 	// tree is the Tree that is being built.
 	tree site.Tree
 
+	// pages are the pages that have been fetched keyed by their path and
+	// query.
+	pages     map[string]*site.Page
+	pagesLock sync.Mutex
+
 	// wg is used in the Stop method so that it blocks until the Crawl method finishes.
 	wg *sync.WaitGroup
+
+	// fetcher makes the HTTP requests for the workers.
+	fetcher Fetcher
+
+	// limiter, if set, limits the number of requests in flight.
+	limiter Limiter
+
+	// workers is the most goroutines that crawl at once, including the one
+	// that reads the sitemaps.
+	workers int
+
+	// previous are the pages from the previous crawl keyed by their path and
+	// query.
+	previous map[string]*site.Page
+
+	// nofollow decides which links are followed.
+	nofollow NofollowPolicy
+
+	// skipDuplicates is true if the links of pages that duplicate an earlier
+	// page aren't followed. originals are the pages that weren't duplicates,
+	// guarded by pagesLock.
+	skipDuplicates bool
+	maxDistance    int
+	originals      []*site.Page
+
+	// traps, if set, detects the URLs that are crawler traps. It is only used
+	// by Crawl's loop. skipped are the URLs that were skipped, guarded by
+	// pagesLock.
+	traps   *trap.Detector
+	skipped []site.SkippedURL
+
+	// rules extract fields from the pages.
+	rules []*extract.Rule
+
+	// canonicalTree is true if the site tree should be built from the pages'
+	// canonical URLs.
+	canonicalTree bool
+
+	// useSitemaps is true if the crawl should be seeded from the sitemaps.
+	// sitemapsRead is true once they have been read, sitemapPages is the
+	// number of pages they list on any host, and sitemapURLs are the URLs on
+	// the site that they list, all guarded by pagesLock.
+	useSitemaps  bool
+	sitemapsRead bool
+	sitemapPages int
+	sitemapURLs  []string
+
+	// mirror, if set, saves the pages and their assets. savedAssets are the
+	// URLs of the assets that have been saved, guarded by pagesLock.
+	mirror      Mirror
+	savedAssets map[string]bool
+
+	// checkAssets is true if the assets used by the pages should be
+	// requested. assetChecks are the results keyed by the asset's URL, guarded
+	// by pagesLock.
+	checkAssets bool
+	assetChecks map[string]*site.URLCheck
+
+	// externalInterval is the time between requests for links to other
+	// sites, or 0 if they aren't requested. externalChecks are the results
+	// keyed by the link's URL, guarded by pagesLock.
+	externalInterval time.Duration
+	externalChecks   map[string]*site.URLCheck
 }
 
-// Crawl starts a spider crawling across a site.
+// foundURL is a URL found by a crawl, along with the URL of the page it was
+// found on. from is nil for the URL the crawl started from and the URLs listed
+// in the sitemaps.
+type foundURL struct {
+	url  *url.URL
+	from *url.URL
+}
+
+// Crawl starts a spider crawling across a site. It returns once Stop is called
+// or there are no more pages to crawl.
 func (s *Spider) Crawl(u *url.URL) {
 
 	s.wg.Add(1)
 	defer s.wg.Done()
-	// seenPaths is a the cache of paths that we have already seen. It is used to
-	// limit the amount of time we spend looking at duplicate paths.
-	seenPaths := make(map[string]bool)
+	defer close(s.done)
+	// seen is a the cache of the URLs that we have already seen, keyed by their
+	// path and query. It is used to limit the amount of time we spend looking
+	// at duplicate URLs.
+	seen := make(map[string]*url.URL)
+
+	// trapURLs caches whether each URL that has been checked for traps was
+	// one, keyed by its path and query.
+	trapURLs := make(map[string]bool)
 
 	// foundURLs is the channel on which the workers send the URLs as it finds
 	// them. Setting the buffer length to an arbitrary number to prevent to many
 	// goroutines blocking at once. There is probably a better way of determining
 	// this number.
-	foundURLs := make(chan *url.URL, 100)
+	foundURLs := make(chan foundURL, 100)
+
+	// workerDone receives a value each time a worker finishes crawling a URL, so
+	// that we know when there is nothing left to crawl.
+	workerDone := make(chan struct{})
+	var inFlight int
 
 	tree := site.Tree{Value: u.Hostname()}
 	var stopped bool
@@ -56,38 +325,88 @@ func (s *Spider) Crawl(u *url.URL) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// Populate our foundURLs channel with our initial url to get things started.
-	foundURLs <- u
+	foundURLs <- foundURL{url: u}
+
+	// Read the sitemaps in the background like any other worker, so that we
+	// can start crawling straight away.
+	if s.useSitemaps {
+		inFlight++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.seedFromSitemaps(ctx, u, foundURLs)
+
+			select {
+			case workerDone <- struct{}{}:
+			case <-ctx.Done():
+			}
+		}()
+	}
+	// pending are the URLs waiting for a worker, in the order they were
+	// found.
+	var pending []*url.URL
+
 	for {
 		if stopped {
 			break
 		}
 
+		// Start a worker for each pending URL, up to the spider's limit, so
+		// that the URLs that are waiting don't each hold a goroutine.
+		for len(pending) > 0 && inFlight < s.workers {
+			url := pending[0]
+			pending[0] = nil
+			pending = pending[1:]
+
+			inFlight++
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := s.crawl(ctx, url, foundURLs); err != nil {
+					log.Printf("Got an error while crawling %s: %v", url, err)
+				}
+
+				// The main loop stops listening once we have been stopped.
+				select {
+				case workerDone <- struct{}{}:
+				case <-ctx.Done():
+				}
+			}()
+		}
+
+		// Workers send the URLs they find before they signal that they are done,
+		// so if nothing is in flight, queued or pending then we have crawled the
+		// whole site.
+		if inFlight == 0 && len(foundURLs) == 0 && len(pending) == 0 {
+			break
+		}
+
 		select {
-		case url := <-foundURLs: // Wait for workers to send back URLs they have found
-			if seenPaths[url.Path] {
+		case found := <-foundURLs: // Wait for workers to send back URLs they have found
+			// Only the URLs that would be crawled are checked for traps, so
+			// that the URLs that were crawled aren't also reported as skipped.
+			url := found.url
+			if _, ok := seen[pageKey(url)]; ok || (url != u && s.isTrap(found, trapURLs)) {
 				continue
 			}
 
 			// Add the path to our site tree
 			tree.Add(url.Path)
-			seenPaths[url.Path] = true
+			seen[pageKey(url)] = url
+			pending = append(pending, url)
 
-			// Spin off a worker to start crawling the URL. It would be better to use
-			// a worker pool for this, so that an indeterminate amount of workers
-			// aren't spun off. I could add an elastic worker pool.
-			wg.Add(1)
-			go func() {
-				if err := crawl(ctx, url, foundURLs); err != nil {
-					log.Println("Got an error while crawling %s: %v", url, err)
-				}
-				wg.Done()
-			}()
+		case <-workerDone:
+			inFlight--
 
 		case <-s.stop: // Listen for the stop signal.
 			stopped = true
 		}
 	}
 
+	if !stopped && s.externalInterval > 0 {
+		s.checkExternalLinks(ctx)
+	}
+
 	// Cancel any log running requests so that we terminate sooner.
 	cancel()
 
@@ -99,18 +418,76 @@ func (s *Spider) Crawl(u *url.URL) {
 	}()
 
 	// Drain the foundPaths channel.
-	for url := range foundURLs {
-		if seenPaths[url.Path] {
+	for found := range foundURLs {
+		url := found.url
+		if _, ok := seen[pageKey(url)]; ok || s.isTrap(found, trapURLs) {
 			continue
 		}
 
 		// Add the path to our site tree
 		tree.Add(url.Path)
+		seen[pageKey(url)] = url
+	}
+
+	if s.canonicalTree {
+		tree = s.buildCanonicalTree(u, seen)
 	}
 
 	s.tree = tree
 }
 
+// isTrap returns true if the spider detects traps and the found URL is one, in
+// which case the URL is recorded as skipped. Each URL is only checked once, and
+// the result is cached in checked.
+func (s *Spider) isTrap(found foundURL, checked map[string]bool) bool {
+	if s.traps == nil {
+		return false
+	}
+
+	u := found.url
+	if trapped, found := checked[pageKey(u)]; found {
+		return trapped
+	}
+
+	reason, trapped := s.traps.Check(u, found.from)
+	checked[pageKey(u)] = trapped
+	if trapped {
+		// Fragments don't change the page that would have been fetched.
+		withoutFragment := *u
+		withoutFragment.Fragment = ""
+		raw := withoutFragment.String()
+
+		s.pagesLock.Lock()
+		s.skipped = append(s.skipped, site.SkippedURL{URL: raw, Reason: string(reason)})
+		s.pagesLock.Unlock()
+	}
+
+	return trapped
+}
+
+// buildCanonicalTree builds a site tree from the paths of the URLs, keyed by
+// their path and query, with the path of each page that has a canonical URL on
+// the same site replaced by the canonical URL's path.
+func (s *Spider) buildCanonicalTree(u *url.URL, urls map[string]*url.URL) site.Tree {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	tree := site.Tree{Value: u.Hostname()}
+	for key, found := range urls {
+		path := found.Path
+		if page := s.pages[key]; page != nil && len(page.Canonical) > 0 {
+			canonical, err := url.Parse(page.Canonical)
+			if err == nil && canonical.Hostname() == u.Hostname() {
+				path = canonical.Path
+			}
+		}
+
+		tree.Add(path)
+	}
+
+	return tree
+}
+
 // Stops a spider from crawling across a site.
 func (s *Spider) Stop() {
 	close(s.stop)
@@ -118,71 +495,408 @@ func (s *Spider) Stop() {
 	s.wg.Wait()
 }
 
+// Done returns a channel that is closed once the spider has finished crawling.
+func (s *Spider) Done() <-chan struct{} {
+	return s.done
+}
+
 // SiteTree returns the spider's site tree. If stop has not been called then it will return nothing.
 func (s *Spider) SiteTree() site.Tree {
 	return s.tree
 }
 
-// crawl crawls the html at the given url and writes the local URLs to the
-// foundURLs channel as it finds them.
-func crawl(ctx context.Context, u *url.URL, foundURLs chan<- *url.URL) error {
-	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), strings.NewReader(u.Query().Encode()))
-	if err != nil {
-		return errors.Wrapf(err, "failed to build request for %s", u)
+// SitemapsRead returns true if the spider read the site's sitemaps, along with
+// the number of pages they list on any host.
+func (s *Spider) SitemapsRead() (bool, int) {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	return s.sitemapsRead, s.sitemapPages
+}
+
+// SitemapURLs returns the URLs on the site that are listed in its sitemaps.
+func (s *Spider) SitemapURLs() []string {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	return append([]string(nil), s.sitemapURLs...)
+}
+
+// SkippedURLs returns the URLs that were skipped as crawler traps, in the order
+// they were found. It returns nil if the spider doesn't detect traps.
+func (s *Spider) SkippedURLs() []site.SkippedURL {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	if s.traps == nil {
+		return nil
+	}
+
+	return append([]site.SkippedURL{}, s.skipped...)
+}
+
+// AssetChecks returns the results of requesting the assets used by the pages,
+// keyed by the asset's URL. It returns nil if the spider doesn't check assets.
+func (s *Spider) AssetChecks() map[string]*site.URLCheck {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	if !s.checkAssets {
+		return nil
 	}
 
-	// Set the accept header so we have chance of the server not sending back some huge binary.
-	req.Header.Set("accept", "text/html")
+	checks := make(map[string]*site.URLCheck, len(s.assetChecks))
+	for u, check := range s.assetChecks {
+		if check != nil {
+			checks[u] = check
+		}
+	}
+
+	return checks
+}
+
+// ExternalLinkChecks returns the results of requesting the links to other
+// sites, keyed by their URL. It returns nil if the spider doesn't check them.
+func (s *Spider) ExternalLinkChecks() map[string]*site.URLCheck {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	if s.externalChecks == nil {
+		return nil
+	}
+
+	checks := make(map[string]*site.URLCheck, len(s.externalChecks))
+	for u, check := range s.externalChecks {
+		checks[u] = check
+	}
+
+	return checks
+}
+
+// Pages returns the pages that the spider fetched keyed by their path and
+// query.
+func (s *Spider) Pages() map[string]*site.Page {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	pages := make(map[string]*site.Page, len(s.pages))
+	for path, page := range s.pages {
+		pages[path] = page
+	}
+
+	return pages
+}
+
+// crawl waits for the limiter, if there is one, before fetching the page at
+// the given url. The page is added to the spider's pages, and the local URLs it
+// links to are written to the foundURLs channel.
+func (s *Spider) crawl(ctx context.Context, u *url.URL, foundURLs chan<- foundURL) error {
+	if s.limiter != nil {
+		if err := s.limiter.Acquire(ctx); err != nil {
+			return nil
+		}
+		defer s.limiter.Release()
+	}
+
+	prev := s.previous[pageKey(u)]
+	if s.mirror != nil || len(s.rules) > 0 {
+		prev = nil
+	}
+
+	f, err := fetch(ctx, s.fetcher, u, prev)
+	if f != nil {
+		if len(s.rules) > 0 && f.doc != nil {
+			f.page.Fields, f.page.Extracted = extract.Extract(s.rules, f.page.URL, f.doc)
+		}
+		if s.skipDuplicates {
+			s.markDuplicate(f.page)
+		}
+		s.addPage(pageKey(u), f.page)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to retrieve the body from %s", u)
+		return err
+	}
+
+	if s.mirror != nil {
+		s.save(ctx, u, f)
 	}
 
-	// There isn't anything for us to do with a resource that isn't a HTML page.
-	if !strings.Contains(resp.Header.Get("content-type"), "text/html") {
+	if s.checkAssets {
+		s.check(ctx, f.page.Assets)
+	}
+
+	// Duplicates link to the same pages as their originals, or to more
+	// duplicates, so there is nothing to gain from following their links.
+	if len(f.page.DuplicateOf) > 0 {
 		return nil
 	}
 
-	// parse the html
-	t := html.NewTokenizer(resp.Body)
-	for tokenType := t.Next(); t.Err() == nil; tokenType = t.Next() {
-		if tokenType != html.StartTagToken {
-			continue
+	for _, link := range s.follow(f.page, f.links) {
+		// Put the URL on the queue of work for the spider to do.
+		foundURLs <- foundURL{url: link, from: u}
+	}
+
+	return nil
+}
+
+// markDuplicate sets the DuplicateOf of a page that has the same text, or
+// nearly the same text, as a page that has already been fetched. Only HTML
+// pages with some text are compared.
+func (s *Spider) markDuplicate(page *site.Page) {
+	page.DuplicateOf = ""
+	if page.Status != http.StatusOK || page.Words == 0 {
+		return
+	}
+
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	for _, orig := range s.originals {
+		if orig.TextHash == page.TextHash || fingerprint.Distance(orig.SimHash, page.SimHash) <= s.maxDistance {
+			page.DuplicateOf = orig.URL
+			return
+		}
+	}
+
+	s.originals = append(s.originals, page)
+}
+
+// follow returns the links from the page that the spider's nofollow policy
+// allows it to follow.
+func (s *Spider) follow(page *site.Page, links []*url.URL) []*url.URL {
+	switch {
+	case s.nofollow == NofollowIgnore:
+		return links
+	case page.NoFollow:
+		return nil
+	case s.nofollow == NofollowPages || len(page.NofollowLinks) == 0:
+		return links
+	}
+
+	nofollow := make(map[string]bool, len(page.NofollowLinks))
+	for _, raw := range page.NofollowLinks {
+		nofollow[raw] = true
+	}
+
+	var followed []*url.URL
+	for _, link := range links {
+		if !nofollow[link.String()] {
+			followed = append(followed, link)
 		}
+	}
+
+	return followed
+}
+
+// save saves the page to the mirror, along with any assets it uses that
+// haven't been saved yet. Errors are logged rather than returned, since the
+// page itself was crawled.
+func (s *Spider) save(ctx context.Context, u *url.URL, f *fetched) {
+	if f.page.Status != http.StatusOK {
+		return
+	}
+
+	// The body is cut off at maxBodySize, so a page that large might be
+	// incomplete.
+	if len(f.body) >= maxBodySize {
+		log.Printf("Not mirroring %s: it is larger than %d bytes", u, maxBodySize)
+		return
+	}
+
+	var assets []*url.URL
+	var err error
+	if f.doc != nil {
+		assets, err = s.mirror.SavePage(u, f.doc)
+	} else {
+		assets, err = s.mirror.SaveAsset(u, f.page.ContentType, f.body)
+	}
+	if err != nil {
+		log.Printf("Failed to mirror %s: %v", u, err)
+	}
 
-		token := t.Token()
-		if token.DataAtom != atom.A {
+	// Stylesheets can use further assets, so keep going until there aren't
+	// any new ones.
+	for len(assets) > 0 {
+		asset := assets[0]
+		assets = assets[1:]
+
+		if !s.markAssetSaved(asset) {
 			continue
 		}
 
-		var hrefVal string
-		for _, attr := range token.Attr {
-			if attr.Key == atom.Href.String() {
-				hrefVal = attr.Val
-				break
-			}
+		contentType, body, err := fetchAsset(ctx, s.fetcher, asset)
+		if err != nil {
+			log.Printf("Failed to fetch asset %s: %v", asset, err)
+			continue
 		}
 
-		// Parses the URL in the context of given url. The provided hrefVal may be
-		// relative or absolute.
-		href, err := u.Parse(hrefVal)
+		more, err := s.mirror.SaveAsset(asset, contentType, body)
 		if err != nil {
-			return errors.Wrapf(err, "failed to parse href %s", hrefVal)
+			log.Printf("Failed to mirror %s: %v", asset, err)
 		}
+		assets = append(assets, more...)
+	}
+}
 
-		if href == nil {
-			continue
+// check requests each of the assets that haven't already been requested.
+func (s *Spider) check(ctx context.Context, assets []site.Asset) {
+	for _, a := range assets {
+		s.pagesLock.Lock()
+		_, found := s.assetChecks[a.URL]
+		if !found {
+			// Claim the asset so that other workers don't request it too.
+			s.assetChecks[a.URL] = nil
 		}
+		s.pagesLock.Unlock()
 
-		// We are only interested in links to the current host.
-		if href.Hostname() != u.Hostname() {
+		if found {
 			continue
 		}
 
-		// Put the URL on the queue of work for the spider to do.
-		foundURLs <- href
+		check := checkURL(ctx, s.fetcher, a.URL)
+
+		s.pagesLock.Lock()
+		s.assetChecks[a.URL] = check
+		s.pagesLock.Unlock()
 	}
+}
 
-	return nil
+// checkExternalLinks requests each of the links to other sites found by the
+// crawl, no faster than one every externalInterval. It returns early if the
+// spider is stopped.
+func (s *Spider) checkExternalLinks(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	s.pagesLock.Lock()
+	seen := map[string]bool{}
+	var links []string
+	for _, page := range s.pages {
+		for _, link := range page.ExternalLinks {
+			if !seen[link] {
+				seen[link] = true
+				links = append(links, link)
+			}
+		}
+	}
+	s.pagesLock.Unlock()
+
+	sort.Strings(links)
+
+	ticker := time.NewTicker(s.externalInterval)
+	defer ticker.Stop()
+
+	for i, link := range links {
+		// Don't wait before the first request.
+		if i > 0 {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if s.limiter != nil {
+			if err := s.limiter.Acquire(ctx); err != nil {
+				return
+			}
+		}
+		check := checkURL(ctx, s.fetcher, link)
+		if s.limiter != nil {
+			s.limiter.Release()
+		}
+
+		// The request was cut short, so it doesn't tell us anything.
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.pagesLock.Lock()
+		s.externalChecks[link] = check
+		s.pagesLock.Unlock()
+	}
+}
+
+// markAssetSaved records that the asset is being saved. It returns false if it
+// has already been saved.
+func (s *Spider) markAssetSaved(u *url.URL) bool {
+	s.pagesLock.Lock()
+	defer s.pagesLock.Unlock()
+
+	// Pages are saved when they are crawled so they aren't saved as assets.
+	if _, found := s.pages[pageKey(u)]; found || s.savedAssets[u.String()] {
+		return false
+	}
+
+	if s.savedAssets == nil {
+		s.savedAssets = map[string]bool{}
+	}
+	s.savedAssets[u.String()] = true
+	return true
+}
+
+// addPage records the page that was fetched for the key.
+func (s *Spider) addPage(key string, page *site.Page) {
+	s.pagesLock.Lock()
+	s.pages[key] = page
+	s.pagesLock.Unlock()
+}
+
+// pageKey returns the key of the page at the URL, which is its path and, if it
+// has one, its query with the parameters sorted. Each key is only crawled once.
+func pageKey(u *url.URL) string {
+	if len(u.RawQuery) == 0 {
+		return u.Path
+	}
+	return u.Path + "?" + u.Query().Encode()
+}
+
+// seedFromSitemaps writes the URLs on the site that are listed in its sitemaps
+// to the foundURLs channel.
+func (s *Spider) seedFromSitemaps(ctx context.Context, u *url.URL, foundURLs chan<- foundURL) {
+	if s.limiter != nil {
+		if err := s.limiter.Acquire(ctx); err != nil {
+			return
+		}
+	}
+
+	pages, errs := sitemap.Read(ctx, s.fetcher, sitemap.Discover(ctx, s.fetcher, u))
+	if s.limiter != nil {
+		s.limiter.Release()
+	}
+
+	for _, err := range errs {
+		log.Printf("Got an error while reading the sitemaps for %s: %v", u, err)
+	}
+
+	s.pagesLock.Lock()
+	s.sitemapsRead = true
+	s.sitemapPages = len(pages)
+	s.pagesLock.Unlock()
+
+	for _, page := range pages {
+		link, err := u.Parse(page)
+		if err != nil || link.Hostname() != u.Hostname() {
+			continue
+		}
+
+		s.pagesLock.Lock()
+		s.sitemapURLs = append(s.sitemapURLs, link.String())
+		s.pagesLock.Unlock()
+
+		select {
+		case foundURLs <- foundURL{url: link}:
+		case <-ctx.Done():
+			return
+		}
+	}
 }
@@ -0,0 +1,100 @@
+package spider
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/document"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/site"
+)
+
+// structuredData extracts the JSON-LD, Microdata, OpenGraph and Twitter card
+// metadata from the document. It returns nil if the document has none.
+func structuredData(doc *document.Document) *site.StructuredData {
+	data := &site.StructuredData{
+		OpenGraph: metaProperties(doc.MetaProperties("og:")),
+		Twitter:   metaProperties(doc.MetaProperties("twitter:")),
+	}
+
+	for _, raw := range doc.JSONLD() {
+		data.JSONLD = append(data.JSONLD, parseJSONLD(raw))
+	}
+
+	for _, item := range doc.Microdata() {
+		data.Microdata = append(data.Microdata, microdataItem(item))
+	}
+
+	if data.Empty() {
+		return nil
+	}
+
+	return data
+}
+
+// parseJSONLD checks that the script is valid JSON and finds the types of its
+// top level objects.
+func parseJSONLD(raw string) site.JSONLD {
+	ld := site.JSONLD{Raw: strings.TrimSpace(raw)}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(ld.Raw), &v); err != nil {
+		ld.Error = err.Error()
+		return ld
+	}
+
+	// A script can hold a single object, an array of objects, or an object
+	// with an @graph of objects.
+	var objects []interface{}
+	switch v := v.(type) {
+	case []interface{}:
+		objects = v
+	case map[string]interface{}:
+		objects = []interface{}{v}
+		if graph, ok := v["@graph"].([]interface{}); ok {
+			objects = append(objects, graph...)
+		}
+	}
+
+	for _, o := range objects {
+		obj, ok := o.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch t := obj["@type"].(type) {
+		case string:
+			ld.Types = append(ld.Types, t)
+		case []interface{}:
+			for _, t := range t {
+				if s, ok := t.(string); ok {
+					ld.Types = append(ld.Types, s)
+				}
+			}
+		}
+	}
+
+	return ld
+}
+
+func microdataItem(item document.Item) site.MicrodataItem {
+	converted := site.MicrodataItem{Types: item.Types, ID: item.ID}
+	for _, p := range item.Properties {
+		prop := site.MicrodataProperty{Name: p.Name, Value: p.Value}
+		if p.Item != nil {
+			nested := microdataItem(*p.Item)
+			prop.Item = &nested
+		}
+		converted.Properties = append(converted.Properties, prop)
+	}
+
+	return converted
+}
+
+func metaProperties(props []document.Property) []site.MetaProperty {
+	var converted []site.MetaProperty
+	for _, p := range props {
+		converted = append(converted, site.MetaProperty{Name: p.Name, Content: p.Content})
+	}
+
+	return converted
+}
@@ -0,0 +1,200 @@
+// Package trap detects crawler traps: URLs, like those of calendars and
+// endlessly nested relative links, that lead a crawler on forever.
+package trap
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Heuristic is the reason a URL was detected as a trap.
+type Heuristic string
+
+const (
+	// RepeatingSegments is a path with the same segment many times, like
+	// /a/b/a/b/a/b.
+	RepeatingSegments Heuristic = "repeating-segments"
+
+	// Depth is a path with too many segments.
+	Depth Heuristic = "depth"
+
+	// QueryVariants is a path that has been seen with too many query strings
+	// that differ in more than their numbers, like those of a page that adds
+	// another parameter to each of its links.
+	QueryVariants Heuristic = "query-variants"
+
+	// Pagination is a long chain of URLs that differ only in their numbers,
+	// each found on the one before it, like the months of a calendar or the
+	// pages of endless pagination.
+	Pagination Heuristic = "pagination"
+)
+
+const (
+	// DefaultMaxRepeats is the most times a segment may appear in a path.
+	DefaultMaxRepeats = 2
+
+	// DefaultMaxDepth is the most segments a path may have.
+	DefaultMaxDepth = 15
+
+	// DefaultMaxQueryVariants is the most query strings that differ in more
+	// than their numbers a path may be seen with.
+	DefaultMaxQueryVariants = 50
+
+	// DefaultMaxPages is the longest chain of URLs that may differ only in
+	// their numbers, each found on the one before it.
+	DefaultMaxPages = 1000
+)
+
+// Options sets the thresholds of the heuristics. Zero values use the defaults.
+type Options struct {
+	MaxRepeats       int
+	MaxDepth         int
+	MaxQueryVariants int
+	MaxPages         int
+}
+
+// Detector detects traps among the URLs found by a crawl. It remembers the
+// URLs it has been given, so a Detector should only be used for one crawl, and
+// each URL should only be given to it once. It isn't safe for concurrent use.
+type Detector struct {
+	opts Options
+
+	// variants are the query strings seen for each path, with their numbers
+	// removed.
+	variants map[string]map[string]bool
+
+	// chains are the number of URLs before each URL with numbers in the chain
+	// of URLs with the same pattern that it was found through.
+	chains map[string]int
+}
+
+// NewDetector creates a Detector with the given thresholds.
+func NewDetector(opts Options) *Detector {
+	if opts.MaxRepeats <= 0 {
+		opts.MaxRepeats = DefaultMaxRepeats
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = DefaultMaxDepth
+	}
+	if opts.MaxQueryVariants <= 0 {
+		opts.MaxQueryVariants = DefaultMaxQueryVariants
+	}
+	if opts.MaxPages <= 0 {
+		opts.MaxPages = DefaultMaxPages
+	}
+
+	return &Detector{
+		opts:     opts,
+		variants: map[string]map[string]bool{},
+		chains:   map[string]int{},
+	}
+}
+
+// Check returns the heuristic that detects the URL as a trap, or false if it
+// isn't one. from is the URL of the page the URL was found on, or nil if it
+// wasn't found on a page. URLs that aren't traps are counted towards the
+// limits on query variants and pagination. A URL with numbers is only a trap
+// once the chain of URLs with the same pattern, each found on the one before,
+// is longer than the limit on pagination, so that the many pages of a site
+// that are linked from an index aren't skipped.
+func (d *Detector) Check(u, from *url.URL) (Heuristic, bool) {
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	if len(segments) > d.opts.MaxDepth {
+		return Depth, true
+	}
+
+	counts := map[string]int{}
+	for _, s := range segments {
+		counts[s]++
+		if counts[s] > d.opts.MaxRepeats {
+			return RepeatingSegments, true
+		}
+	}
+
+	pattern, numeric := numberPattern(u)
+	if len(u.RawQuery) > 0 && !d.add(d.variants, u.Path, pattern.query, d.opts.MaxQueryVariants) {
+		return QueryVariants, true
+	}
+
+	if numeric {
+		var length int
+		if from != nil {
+			if fromPattern, _ := numberPattern(from); fromPattern == pattern {
+				length = d.chains[key(from)] + 1
+			}
+		}
+		if length > d.opts.MaxPages {
+			return Pagination, true
+		}
+		d.chains[key(u)] = length
+	}
+
+	return "", false
+}
+
+// add adds the value to the set for the key, and returns false if the set
+// already has max values and doesn't include the value.
+func (d *Detector) add(sets map[string]map[string]bool, key, val string, max int) bool {
+	set := sets[key]
+	if set == nil {
+		set = map[string]bool{}
+		sets[key] = set
+	}
+
+	if set[val] {
+		return true
+	}
+	if len(set) >= max {
+		return false
+	}
+
+	set[val] = true
+	return true
+}
+
+// numbers matches the runs of digits in a path or query parameter.
+var numbers = regexp.MustCompile(`[0-9]+`)
+
+// pattern is a URL's path and normalized query with their numbers removed.
+type pattern struct {
+	path  string
+	query string
+}
+
+// numberPattern returns the URL's path and query with every run of digits
+// replaced by a placeholder, and whether there were any.
+func numberPattern(u *url.URL) (pattern, bool) {
+	replace := func(s string) string { return numbers.ReplaceAllLiteralString(s, "{n}") }
+
+	query := u.Query()
+	params := make(url.Values, len(query))
+	for key, vals := range query {
+		for _, val := range vals {
+			params.Add(key, replace(val))
+		}
+	}
+
+	p := pattern{path: replace(u.Path), query: normalizeQuery(params)}
+	return p, p.path != u.Path || p.query != normalizeQuery(query)
+}
+
+// key returns the URL's path and normalized query, which identify the page the
+// URL is for.
+func key(u *url.URL) string {
+	if len(u.RawQuery) == 0 {
+		return u.Path
+	}
+	return u.Path + "?" + normalizeQuery(u.Query())
+}
+
+// normalizeQuery encodes the query with its parameters sorted, so that the
+// same parameters in a different order aren't counted as another variant.
+func normalizeQuery(query url.Values) string {
+	for _, vals := range query {
+		sort.Strings(vals)
+	}
+
+	return query.Encode()
+}
@@ -0,0 +1,292 @@
+package warc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Archive indexes the responses in a set of WARC files by their URL. It is an
+// http.RoundTripper that serves the archived responses, so that a site can be
+// crawled again without network access. Used as the transport of an
+// http.Client, archived redirects are followed like they were when the site
+// was crawled.
+//
+// Only where each response is in the files is kept in memory, and the files
+// are read again when the response is requested, so they must not be removed
+// while the archive is in use.
+type Archive struct {
+	responses map[string]location
+}
+
+// location is where a response record is in a WARC file. In a gzip
+// compressed file offset is where the gzip member holding the record starts,
+// and skip is the number of records before it in the member. In an
+// uncompressed file offset is where the record starts.
+type location struct {
+	path   string
+	offset int64
+	skip   int
+}
+
+// Open indexes the responses in the WARC files at the given paths. A path may
+// also be a directory, in which case every .warc and .warc.gz file in it is
+// indexed. If a URL was archived more than once then the last response is
+// used, unless it is a 304 Not Modified.
+func Open(paths ...string) (*Archive, error) {
+	a := &Archive{responses: map[string]location{}}
+	for _, path := range paths {
+		files, err := Files(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			if err := a.index(file); err != nil {
+				return nil, errors.Wrapf(err, "failed to read %s", file)
+			}
+		}
+	}
+
+	return a, nil
+}
+
+// Len returns the number of URLs that have an archived response.
+func (a *Archive) Len() int {
+	return len(a.responses)
+}
+
+// RoundTrip returns the archived response for the request's URL. An error is
+// returned if the URL wasn't archived.
+func (a *Archive) RoundTrip(req *http.Request) (*http.Response, error) {
+	loc, found := a.responses[req.URL.String()]
+	if !found {
+		return nil, errors.Errorf("%s is not in the archive", req.URL)
+	}
+
+	record, err := loc.read()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the archived response for %s from %s", req.URL, loc.path)
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(record.Block)), req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read the archived response for %s", req.URL)
+	}
+
+	// Other archivers keep the body as it was sent, so decode it like the
+	// HTTP client would.
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decompress the archived response for %s", req.URL)
+		}
+		resp.Body = readCloser{gz, resp.Body}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+	}
+
+	if req.Method == http.MethodHead {
+		resp.Body.Close()
+		resp.Body = http.NoBody
+	}
+
+	return resp, nil
+}
+
+// index adds where the responses in a WARC file are to the archive.
+func (a *Archive) index(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	counter := &countingReader{r: f}
+	br := bufio.NewReader(counter)
+	magic, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	if !bytes.Equal(magic, gzipMagic) {
+		r := &Reader{r: br}
+		return a.indexRecords(r, func(int) location {
+			return location{path: path, offset: r.start}
+		})
+	}
+
+	// Each gzip member is read on its own so that the offset it starts at is
+	// known. The gzip reader reads no further than the end of the member
+	// because br is an io.ByteReader.
+	gz := new(gzip.Reader)
+	for {
+		offset := counter.n - int64(br.Buffered())
+		if err := gz.Reset(br); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrap(err, "failed to decompress WARC file")
+		}
+		gz.Multistream(false)
+
+		err := a.indexRecords(&Reader{r: bufio.NewReader(gz)}, func(skip int) location {
+			return location{path: path, offset: offset, skip: skip}
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// indexRecords adds the responses read by r to the archive. locate returns the
+// location of the response given the number of records before it.
+func (a *Archive) indexRecords(r *Reader, locate func(skip int) location) error {
+	for skip := 0; ; skip++ {
+		record, err := r.nextHeader()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if record.Type() != TypeResponse || !strings.HasPrefix(record.Header.Get("Content-Type"), "application/http") {
+			continue
+		}
+
+		// WARC 1.0 writers may wrap the URI in angle brackets.
+		target, err := url.Parse(strings.Trim(record.Header.Get("WARC-Target-URI"), "<>"))
+		if err != nil {
+			continue
+		}
+
+		key := target.String()
+		if _, found := a.responses[key]; found && isNotModified(r.peekBlock(statusLineLength)) {
+			continue
+		}
+		a.responses[key] = locate(skip)
+	}
+}
+
+// read reads the record at the location.
+func (l location) read() (*Record, error) {
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(l.offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	r, err := NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < l.skip; i++ {
+		if _, err := r.nextHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.Next()
+}
+
+// statusLineLength is enough of a response to read its status code.
+const statusLineLength = 64
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// isNotModified returns true if the HTTP response has a 304 status.
+func isNotModified(block []byte) bool {
+	line := block
+	if i := bytes.IndexByte(block, '\n'); i >= 0 {
+		line = block[:i]
+	}
+
+	fields := strings.Fields(string(line))
+	return len(fields) > 1 && fields[1] == "304"
+}
+
+// Files returns the path if it is a file, or the .warc and .warc.gz files in it
+// if it is a directory.
+func Files(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if name := entry.Name(); strings.HasSuffix(name, ".warc") || strings.HasSuffix(name, ".warc.gz") {
+			files = append(files, filepath.Join(path, name))
+		}
+	}
+
+	return files, nil
+}
+
+// ReadInfo returns the fields of the warcinfo record that the WARC file at the
+// path starts with, or nil if it doesn't start with one.
+func ReadInfo(path string) (Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := r.Next()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if record.Type() != TypeWarcinfo {
+		return nil, nil
+	}
+
+	var info Header
+	for _, line := range strings.Split(string(record.Block), "\n") {
+		if parts := strings.SplitN(line, ":", 2); len(parts) == 2 {
+			info = append(info, Field{Name: strings.TrimSpace(parts[0]), Value: strings.TrimSpace(parts[1])})
+		}
+	}
+
+	return info, nil
+}
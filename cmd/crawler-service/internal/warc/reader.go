@@ -0,0 +1,142 @@
+package warc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// gzipMagic is the magic number that gzip compressed files start with.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// Reader reads the records from a WARC file, which may be gzip compressed.
+type Reader struct {
+	r *bufio.Reader
+
+	// read is the number of uncompressed bytes that have been read, and start
+	// is where the last record started.
+	read, start int64
+
+	// remaining is the length of the last record's block that hasn't been
+	// read yet.
+	remaining int64
+}
+
+// NewReader returns a reader of the records in r. Gzip compressed files are
+// detected from their magic number.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if bytes.Equal(magic, gzipMagic) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress WARC file")
+		}
+		br = bufio.NewReader(gz)
+	}
+
+	return &Reader{r: br}, nil
+}
+
+// Next returns the next record. io.EOF is returned once there are no more
+// records.
+func (r *Reader) Next() (*Record, error) {
+	record, err := r.nextHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	record.Block = make([]byte, r.remaining)
+	if _, err := io.ReadFull(r.r, record.Block); err != nil {
+		return nil, errors.Wrap(err, "failed to read WARC record")
+	}
+	r.read += r.remaining
+	r.remaining = 0
+
+	return record, nil
+}
+
+// nextHeader returns the header of the next record, skipping whatever is left
+// of the last record's block. The block is left to be read from r.r.
+func (r *Reader) nextHeader() (*Record, error) {
+	if r.remaining > 0 {
+		if _, err := io.CopyN(ioutil.Discard, r.r, r.remaining); err != nil {
+			return nil, errors.Wrap(err, "failed to skip WARC record")
+		}
+		r.read += r.remaining
+		r.remaining = 0
+	}
+
+	// Skip any blank lines left between records.
+	var line string
+	for len(line) == 0 {
+		var err error
+		r.start = r.read
+		line, err = r.readLine()
+		if err == io.EOF && len(line) == 0 {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !strings.HasPrefix(line, "WARC/1.") {
+		return nil, errors.Errorf("expected a WARC record but got %q", line)
+	}
+
+	record := &Record{}
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read WARC header")
+		}
+		if len(line) == 0 {
+			break
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("malformed WARC header field %q", line)
+		}
+		record.Header = append(record.Header, Field{Name: parts[0], Value: strings.TrimSpace(parts[1])})
+	}
+
+	length, err := strconv.ParseInt(record.Header.Get("Content-Length"), 10, 64)
+	if err != nil || length < 0 {
+		return nil, errors.Errorf("WARC record %s has an invalid Content-Length", record.Header.Get("WARC-Record-ID"))
+	}
+
+	r.remaining = length
+
+	return record, nil
+}
+
+// peekBlock returns up to n bytes from the start of the block of the record
+// returned by nextHeader, without reading them.
+func (r *Reader) peekBlock(n int) []byte {
+	if int64(n) > r.remaining {
+		n = int(r.remaining)
+	}
+
+	// A short block is reported when the block is read.
+	peeked, _ := r.r.Peek(n)
+	return peeked
+}
+
+// readLine reads a line without its line ending.
+func (r *Reader) readLine() (string, error) {
+	line, err := r.r.ReadString('\n')
+	r.read += int64(len(line))
+	return strings.TrimRight(line, "\r\n"), err
+}
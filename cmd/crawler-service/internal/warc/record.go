@@ -0,0 +1,72 @@
+// Package warc reads and writes WARC 1.1 archives of the requests made by a
+// crawl and the responses that were received.
+package warc
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"fmt"
+	"strings"
+)
+
+// Version is the version of the WARC format that records are written in.
+const Version = "WARC/1.1"
+
+// Record types.
+const (
+	TypeWarcinfo = "warcinfo"
+	TypeRequest  = "request"
+	TypeResponse = "response"
+)
+
+// Field is a named field in a WARC record header.
+type Field struct {
+	Name, Value string
+}
+
+// Header is the fields of a WARC record, in the order they are written.
+type Header []Field
+
+// Get returns the value of the first field with the given name, which is
+// matched case insensitively. An empty string is returned if there isn't one.
+func (h Header) Get(name string) string {
+	for _, f := range h {
+		if strings.EqualFold(f.Name, name) {
+			return f.Value
+		}
+	}
+
+	return ""
+}
+
+// Record is a single WARC record.
+type Record struct {
+	Header Header
+	Block  []byte
+}
+
+// Type returns the record's WARC-Type.
+func (r *Record) Type() string {
+	return r.Header.Get("WARC-Type")
+}
+
+// newRecordID returns a random URN to identify a record.
+func newRecordID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	// Set the version and variant bits of a version 4 UUID.
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("<urn:uuid:%x-%x-%x-%x-%x>", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// digest returns the SHA-1 digest of b in the form used by WARC-Block-Digest
+// and WARC-Payload-Digest.
+func digest(b []byte) string {
+	sum := sha1.Sum(b)
+	return "sha1:" + base32.StdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,123 @@
+package warc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// maxPayloadSize is the most of a response body that is archived. Larger
+// bodies are truncated in the archive, but are still passed on in full.
+const maxPayloadSize = 100 << 20
+
+// Recorder is an http.RoundTripper that archives each request it makes, and the
+// response that it receives, to a Writer. Used as the transport of an
+// http.Client, every hop of a redirect is archived under its own URI.
+type Recorder struct {
+	transport http.RoundTripper
+	w         *Writer
+}
+
+// NewRecorder returns a Recorder that makes its requests with the transport and
+// writes them to w. If the transport is nil then http.DefaultTransport is used.
+func NewRecorder(transport http.RoundTripper, w *Writer) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Recorder{transport: transport, w: w}
+}
+
+// RoundTrip makes the request and archives it along with its response.
+// Requests that fail without a response aren't archived.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxPayloadSize))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	// Put back what we have read so that the caller still gets the whole body.
+	resp.Body = readCloser{io.MultiReader(bytes.NewReader(payload), resp.Body), resp.Body}
+
+	uri := req.URL.String()
+	responseHeader := Header{
+		{"WARC-Type", TypeResponse},
+		{"WARC-Target-URI", uri},
+		{"Content-Type", "application/http;msgtype=response"},
+		{"WARC-Payload-Digest", digest(payload)},
+	}
+	if len(payload) == maxPayloadSize {
+		responseHeader = append(responseHeader, Field{"WARC-Truncated", "length"})
+	}
+
+	responseID, err := r.w.Write(responseHeader, responseBlock(resp, payload))
+	if err != nil {
+		resp.Body.Close()
+		return nil, errors.Wrapf(err, "failed to archive the response from %s", uri)
+	}
+
+	_, err = r.w.Write(Header{
+		{"WARC-Type", TypeRequest},
+		{"WARC-Target-URI", uri},
+		{"WARC-Concurrent-To", responseID},
+		{"Content-Type", "application/http;msgtype=request"},
+	}, requestBlock(req))
+	if err != nil {
+		resp.Body.Close()
+		return nil, errors.Wrapf(err, "failed to archive the request to %s", uri)
+	}
+
+	return resp, nil
+}
+
+// requestBlock returns the request as it is sent on the wire, without the
+// headers that the transport adds.
+func requestBlock(req *http.Request) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s HTTP/1.1\r\n", req.Method, req.URL.RequestURI())
+
+	host := req.Host
+	if len(host) == 0 {
+		host = req.URL.Host
+	}
+	fmt.Fprintf(&buf, "Host: %s\r\n", host)
+	req.Header.Write(&buf)
+	buf.WriteString("\r\n")
+
+	return buf.Bytes()
+}
+
+// responseBlock returns the response's status line, headers and payload.
+func responseBlock(resp *http.Response, payload []byte) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "HTTP/%d.%d %s\r\n", resp.ProtoMajor, resp.ProtoMinor, resp.Status)
+
+	// The transport has already removed any transfer encoding, so the payload
+	// is written with its actual length.
+	header := resp.Header.Clone()
+	if resp.ContentLength >= 0 || len(header.Get("Content-Length")) > 0 {
+		header.Set("Content-Length", strconv.Itoa(len(payload)))
+	}
+	header.Write(&buf)
+	buf.WriteString("\r\n")
+	buf.Write(payload)
+
+	return buf.Bytes()
+}
+
+// readCloser reads from one reader and closes another.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,193 @@
+package warc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxSize is the size that a WARC file is rotated at if a writer isn't
+// given one.
+const DefaultMaxSize = 1 << 30
+
+// Writer writes records to gzip compressed WARC files in a directory. Each
+// record is compressed separately, so that the files can be read from any
+// record. A new file is started once the current file reaches its maximum
+// size, and every file starts with a warcinfo record.
+//
+// Files are written with an .open suffix, which is removed once they have been
+// rotated or the writer is closed.
+type Writer struct {
+	dir    string
+	prefix string
+
+	// maxSize is the compressed size a file is rotated at.
+	maxSize int64
+
+	// info are the fields of the warcinfo record at the start of each file.
+	info Header
+
+	lock sync.Mutex
+
+	// file is the file being written to, or nil if a file hasn't been started.
+	// size is how much has been written to it.
+	file   *os.File
+	name   string
+	size   int64
+	infoID string
+	seq    int
+}
+
+// NewWriter returns a writer that writes files named prefix-<timestamp>-<n>.warc.gz
+// to dir, rotating them once they are larger than maxSize bytes. The info
+// fields are written to the warcinfo record at the start of every file. Files
+// aren't created until the first record is written.
+func NewWriter(dir, prefix string, maxSize int64, info Header) *Writer {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+
+	return &Writer{dir: dir, prefix: prefix, maxSize: maxSize, info: info}
+}
+
+// Write writes a record with the given header fields and block. The
+// WARC-Record-ID, WARC-Date, WARC-Warcinfo-ID, WARC-Block-Digest and
+// Content-Length fields are added. It returns the ID of the record.
+func (w *Writer) Write(h Header, block []byte) (string, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return "", err
+		}
+	}
+
+	id := newRecordID()
+	h = append(Header{
+		{"WARC-Type", h.Get("WARC-Type")},
+		{"WARC-Record-ID", id},
+		{"WARC-Date", time.Now().UTC().Format(time.RFC3339Nano)},
+		{"WARC-Warcinfo-ID", w.infoID},
+	}, withoutField(h, "WARC-Type")...)
+
+	if err := w.write(h, block); err != nil {
+		return "", err
+	}
+
+	if w.size >= w.maxSize {
+		if err := w.rotate(); err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// Close finishes the current file.
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	return w.rotate()
+}
+
+// open starts a new file and writes its warcinfo record.
+func (w *Writer) open() error {
+	w.seq++
+	w.name = fmt.Sprintf("%s-%s-%05d.warc.gz", w.prefix, time.Now().UTC().Format("20060102150405"), w.seq)
+
+	file, err := os.OpenFile(filepath.Join(w.dir, w.name+".open"), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create WARC file")
+	}
+	w.file = file
+	w.size = 0
+
+	var fields []byte
+	for _, f := range w.info {
+		fields = append(fields, f.Name+": "+f.Value+"\r\n"...)
+	}
+
+	w.infoID = newRecordID()
+	return w.write(Header{
+		{"WARC-Type", TypeWarcinfo},
+		{"WARC-Record-ID", w.infoID},
+		{"WARC-Date", time.Now().UTC().Format(time.RFC3339Nano)},
+		{"WARC-Filename", w.name},
+		{"Content-Type", "application/warc-fields"},
+	}, fields)
+}
+
+// rotate closes the current file and removes its .open suffix. The next file
+// is started by the next call to Write.
+func (w *Writer) rotate() error {
+	path := filepath.Join(w.dir, w.name)
+	err := w.file.Close()
+	w.file = nil
+	if err != nil {
+		return errors.Wrap(err, "failed to close WARC file")
+	}
+
+	return errors.Wrap(os.Rename(path+".open", path), "failed to rename WARC file")
+}
+
+// write compresses a record as its own gzip member and appends it to the file.
+func (w *Writer) write(h Header, block []byte) error {
+	counter := &countingWriter{w: w.file}
+	gz := gzip.NewWriter(counter)
+	bw := bufio.NewWriter(gz)
+
+	bw.WriteString(Version + "\r\n")
+	for _, f := range h {
+		bw.WriteString(f.Name + ": " + f.Value + "\r\n")
+	}
+	bw.WriteString("WARC-Block-Digest: " + digest(block) + "\r\n")
+	bw.WriteString("Content-Length: " + strconv.Itoa(len(block)) + "\r\n\r\n")
+	bw.Write(block)
+	bw.WriteString("\r\n\r\n")
+
+	err := bw.Flush()
+	if err == nil {
+		err = gz.Close()
+	}
+	w.size += counter.n
+
+	return errors.Wrapf(err, "failed to write to %s", w.name)
+}
+
+// withoutField returns the fields in h that don't have the given name.
+func withoutField(h Header, name string) Header {
+	fields := make(Header, 0, len(h))
+	for _, f := range h {
+		if f.Name != name {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
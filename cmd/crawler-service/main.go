@@ -1,31 +1,198 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/auth"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/netpolicy"
 	"github.com/wrrn/crawler/cmd/crawler-service/internal/service"
+	"github.com/wrrn/crawler/cmd/crawler-service/internal/warc"
 	"github.com/wrrn/crawler/pkg/crawler"
 	"google.golang.org/grpc"
 )
 
 func main() {
 	var (
-		listenAddr = flag.String("listen-address", ":5555", "the address that the service should listen on")
+		listenAddr      = flag.String("listen-address", ":5555", "the address that the service should listen on")
+		retainCrawls    = flag.Int("retain-crawls", service.DefaultRetainCrawls, "the number of crawl results to keep per URL, 0 keeps every result")
+		retainFor       = flag.Duration("retain-for", 0, "how long to keep crawl results, 0 keeps them forever")
+		janitorInterval = flag.Duration("janitor-interval", time.Minute, "how often expired crawl results are removed, must be positive")
+		tlsCert         = flag.String("tls-cert", "", "the PEM encoded certificate to serve TLS with")
+		tlsKey          = flag.String("tls-key", "", "the PEM encoded private key for -tls-cert")
+		tlsClientCA     = flag.String("tls-client-ca", "", "the PEM encoded CA bundle used to verify client certificates, enables mutual TLS")
+		tokenFile       = flag.String("auth-token-file", "", "a JSON file of static bearer tokens, enables authentication")
+		jwtSecretFile   = flag.String("auth-jwt-secret-file", "", "a file containing the secret used to verify HS256 JWT bearer tokens, enables authentication")
+		allowPrivate    = flag.Bool("allow-private-networks", false, "allow crawling loopback, private, link-local and other reserved addresses")
+		allowCIDRs      = flag.String("allow-cidrs", "", "a comma separated list of CIDRs that may be crawled even if they are private")
+		denyCIDRs       = flag.String("deny-cidrs", "", "a comma separated list of CIDRs that may never be crawled")
+		allowHosts      = flag.String("allow-hosts", "", "a comma separated list of the only hosts that may be crawled, *.example.com matches example.com and its subdomains")
+		denyHosts       = flag.String("deny-hosts", "", "a comma separated list of hosts that may never be crawled, *.example.com matches example.com and its subdomains")
+		maxCrawls       = flag.Int("max-crawls", 4, "the number of crawls that may run at once, further crawls are queued, 0 is unlimited")
+		maxFetches      = flag.Int("max-fetches", 32, "the number of requests that may be in flight across every crawl, 0 is unlimited")
+		warcDir         = flag.String("warc-dir", "", "the directory that crawls started with the archive option are written to as WARC files")
+		warcMaxSize     = flag.Int64("warc-max-size", warc.DefaultMaxSize, "the size in bytes that WARC files are rotated at")
+		warcAll         = flag.Bool("warc-all", false, "archive every crawl to -warc-dir, not only the crawls started with the archive option")
+		mirrorDir       = flag.String("mirror-dir", "", "the directory that crawls started with the mirror option are saved to, in a directory named after the crawl's ID")
+		mirrorMaxSize   = flag.Int64("mirror-max-size", 1<<30, "the most bytes saved by each mirrored crawl, 0 is unlimited")
+		mirrorMaxFile   = flag.Int64("mirror-max-file-size", 10<<20, "the largest file in bytes saved by mirrored crawls, 0 is unlimited")
+		replayWARCs     = flag.String("replay-warcs", "", "a comma separated list of WARC files or directories to serve every crawl from instead of the network")
 	)
 
 	flag.Parse()
+
+	if *janitorInterval <= 0 {
+		fmt.Fprintf(os.Stderr, "The janitor interval must be positive, got %v\n", *janitorInterval)
+		os.Exit(1)
+	}
+
+	var serverOpts []grpc.ServerOption
+	if len(*tlsCert) > 0 || len(*tlsKey) > 0 {
+		creds, err := tlsServerOption(*tlsCert, *tlsKey, *tlsClientCA)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to setup TLS: %v\n", err)
+			os.Exit(1)
+		}
+		serverOpts = append(serverOpts, creds)
+	} else if len(*tlsClientCA) > 0 {
+		fmt.Fprintln(os.Stderr, "-tls-client-ca requires -tls-cert and -tls-key")
+		os.Exit(1)
+	}
+
+	authenticator, err := loadAuthenticator(*tokenFile, *jwtSecretFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to setup authentication: %v\n", err)
+		os.Exit(1)
+	}
+
+	if authenticator != nil {
+		serverOpts = append(serverOpts,
+			grpc.UnaryInterceptor(auth.UnaryServerInterceptor(authenticator)),
+			grpc.StreamInterceptor(auth.StreamServerInterceptor(authenticator)),
+		)
+	}
+
+	policy, err := buildNetworkPolicy(*allowPrivate, *allowCIDRs, *denyCIDRs, *allowHosts, *denyHosts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to setup the network policy: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(*warcDir) > 0 {
+		if err := os.MkdirAll(*warcDir, 0755); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create the WARC directory: %v\n", err)
+			os.Exit(1)
+		}
+	} else if *warcAll {
+		fmt.Fprintln(os.Stderr, "-warc-all requires -warc-dir")
+		os.Exit(1)
+	}
+
+	opts := []service.Option{
+		service.WithRetainCrawls(*retainCrawls),
+		service.WithRetainFor(*retainFor),
+		service.WithNetworkPolicy(policy),
+		service.WithMaxCrawls(*maxCrawls),
+		service.WithMaxFetches(*maxFetches),
+		service.WithArchive(*warcDir, *warcMaxSize, *warcAll),
+		service.WithMirror(*mirrorDir, *mirrorMaxSize, *mirrorMaxFile),
+	}
+
+	if paths := splitList(*replayWARCs); len(paths) > 0 {
+		archive, err := warc.Open(paths...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to open the WARC files to replay: %v\n", err)
+			os.Exit(1)
+		}
+		opts = append(opts, service.WithReplay(archive))
+	}
+
 	listener, err := net.Listen("tcp", *listenAddr)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed listen on %s", *listenAddr)
 		os.Exit(1)
 	}
 
-	// TODO(wh): Setup to use TLS.
-	server := grpc.NewServer()
-	crawler.RegisterCrawlerServer(server, service.New())
+	svc := service.New(opts...)
+	go svc.RunJanitor(context.Background(), *janitorInterval)
+	go svc.RunScheduler(context.Background())
+
+	server := grpc.NewServer(serverOpts...)
+	crawler.RegisterCrawlerServer(server, svc)
 
 	server.Serve(listener)
 }
+
+// loadAuthenticator builds an authenticator from the token file and JWT secret
+// file. Nil is returned if neither is set, which disables authentication.
+func loadAuthenticator(tokenFile, jwtSecretFile string) (auth.Authenticator, error) {
+	var chain auth.Chain
+	if len(tokenFile) > 0 {
+		tokens, err := auth.LoadTokenFile(tokenFile)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, tokens)
+	}
+
+	if len(jwtSecretFile) > 0 {
+		secret, err := ioutil.ReadFile(jwtSecretFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the JWT secret")
+		}
+
+		secret = bytes.TrimSpace(secret)
+		if len(secret) == 0 {
+			return nil, errors.Errorf("%s is empty", jwtSecretFile)
+		}
+		chain = append(chain, auth.NewJWT(secret))
+	}
+
+	if len(chain) == 0 {
+		return nil, nil
+	}
+
+	return chain, nil
+}
+
+// buildNetworkPolicy creates the policy that restricts which hosts may be
+// crawled from the command line flags.
+func buildNetworkPolicy(allowPrivate bool, allowCIDRs, denyCIDRs, allowHosts, denyHosts string) (*netpolicy.Policy, error) {
+	allowedNets, err := netpolicy.ParseCIDRs(splitList(allowCIDRs))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid -allow-cidrs")
+	}
+
+	deniedNets, err := netpolicy.ParseCIDRs(splitList(denyCIDRs))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid -deny-cidrs")
+	}
+
+	return &netpolicy.Policy{
+		AllowPrivate: allowPrivate,
+		AllowedNets:  allowedNets,
+		DeniedNets:   deniedNets,
+		AllowedHosts: splitList(allowHosts),
+		DeniedHosts:  splitList(denyHosts),
+	}, nil
+}
+
+// splitList splits a comma separated list, ignoring empty entries.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// maxReportRows is the most assets and pages that are printed in each section
+// of the asset report.
+const maxReportRows = 20
+
+// printAssetReport prints the broken assets, the assets used by the most
+// pages, and the heaviest pages.
+func printAssetReport(report *crawler.AssetReportResponse) {
+	var thirdParty int
+	var broken []*crawler.AssetUsage
+	for _, a := range report.GetAssets() {
+		if a.GetThirdParty() {
+			thirdParty++
+		}
+		if a.GetBroken() {
+			broken = append(broken, a)
+		}
+	}
+
+	fmt.Printf("crawl %s: %d assets, %d same-site and %d third-party\n",
+		report.GetId(), len(report.GetAssets()), len(report.GetAssets())-thirdParty, thirdParty)
+
+	if !report.GetChecked() {
+		fmt.Println("\nThe assets weren't checked, start the crawl with -check-assets to find broken assets and their sizes.")
+	} else {
+		fmt.Printf("\nBroken assets (%d):\n", len(broken))
+		for _, a := range broken {
+			problem := a.GetError()
+			if len(problem) == 0 {
+				problem = fmt.Sprint(a.GetStatus())
+			}
+			fmt.Printf("  %s %s (%s, used by %s)\n", a.GetUrl(), problem, a.GetType(), plural(int(a.GetPages()), "page"))
+		}
+	}
+
+	fmt.Println("\nMost used assets:")
+	for i, a := range report.GetAssets() {
+		if i == maxReportRows {
+			fmt.Printf("  ... and %d more\n", len(report.GetAssets())-i)
+			break
+		}
+
+		party := "same-site"
+		if a.GetThirdParty() {
+			party = "third-party"
+		}
+		fmt.Printf("  %-9s %-10s %-11s %9s  %s\n", plural(int(a.GetPages()), "page"), a.GetType(), party, formatSize(a.GetSize()), a.GetUrl())
+	}
+
+	fmt.Println("\nHeaviest pages:")
+	for i, p := range report.GetPages() {
+		if i == maxReportRows {
+			fmt.Printf("  ... and %d more\n", len(report.GetPages())-i)
+			break
+		}
+
+		fmt.Printf("  %9s  %s (%s of HTML and %s)", formatSize(p.GetTotalSize()), p.GetUrl(), formatSize(p.GetHtmlSize()), plural(int(p.GetAssets()), "asset"))
+		if p.GetUnknownSizes() > 0 {
+			fmt.Printf(", %d of unknown size", p.GetUnknownSizes())
+		}
+		fmt.Println()
+	}
+}
+
+// formatSize formats a size in bytes for people to read. Negative sizes are
+// unknown.
+func formatSize(size int64) string {
+	switch {
+	case size < 0:
+		return "?"
+	case size < 1<<10:
+		return fmt.Sprintf("%d B", size)
+	case size < 1<<20:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	}
+}
+
+// plural formats the count with the noun, adding an s if it isn't 1.
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
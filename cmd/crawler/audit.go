@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// auditIssueNames describe each type of audit issue.
+var auditIssueNames = map[crawler.AuditIssueType]string{
+	crawler.AuditIssueType_MISSING_TITLE:         "Missing title",
+	crawler.AuditIssueType_DUPLICATE_TITLE:       "Duplicate title",
+	crawler.AuditIssueType_MISSING_DESCRIPTION:   "Missing meta description",
+	crawler.AuditIssueType_DUPLICATE_DESCRIPTION: "Duplicate meta description",
+	crawler.AuditIssueType_MISSING_H1:            "Missing h1",
+	crawler.AuditIssueType_MULTIPLE_H1:           "Multiple h1",
+	crawler.AuditIssueType_CANONICAL_ELSEWHERE:   "Canonical points elsewhere",
+	crawler.AuditIssueType_NOINDEX:               "Not indexed",
+	crawler.AuditIssueType_LONG_URL:              "Long URL",
+	crawler.AuditIssueType_THIN_CONTENT:          "Thin content",
+}
+
+// printAuditReport prints each issue found by the audit followed by the pages
+// it was found on. Pages with a duplicate title or description are grouped by
+// the duplicated value.
+func printAuditReport(report *crawler.AuditResponse) {
+	fmt.Printf("crawl %s: audited %s, found %s\n", report.GetId(), plural(int(report.GetPages()), "page"), plural(len(report.GetIssues()), "issue"))
+
+	for _, issue := range report.GetIssues() {
+		name, ok := auditIssueNames[issue.GetType()]
+		if !ok {
+			name = issue.GetType().String()
+		}
+		fmt.Printf("\n%s (%s):\n", name, plural(len(issue.GetPages()), "page"))
+
+		grouped := issue.GetType() == crawler.AuditIssueType_DUPLICATE_TITLE || issue.GetType() == crawler.AuditIssueType_DUPLICATE_DESCRIPTION
+		var group string
+		for i, page := range issue.GetPages() {
+			switch {
+			case grouped:
+				if i == 0 || page.GetDetail() != group {
+					group = page.GetDetail()
+					fmt.Printf("  %q\n", group)
+				}
+				fmt.Println("    " + page.GetUrl())
+			case len(page.GetDetail()) > 0:
+				fmt.Printf("  %s (%s)\n", page.GetUrl(), page.GetDetail())
+			default:
+				fmt.Println("  " + page.GetUrl())
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// printCanonicalReport prints the canonical chains, the canonicals that point
+// to pages that didn't respond with 200 OK, and the hreflang clusters with
+// missing return links.
+func printCanonicalReport(report *crawler.CanonicalReportResponse) {
+	fmt.Printf("crawl %s: %s, %s, %s\n", report.GetId(),
+		plural(len(report.GetChains()), "canonical chain"),
+		plural(len(report.GetBroken()), "broken canonical"),
+		plural(len(report.GetHreflangClusters()), "hreflang cluster")+" missing return links")
+
+	fmt.Printf("\nCanonical chains (%d):\n", len(report.GetChains()))
+	for _, chain := range report.GetChains() {
+		fmt.Print("  " + strings.Join(chain.GetUrls(), " -> "))
+		if chain.GetLoop() {
+			fmt.Print(" (loop)")
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\nBroken canonicals (%d):\n", len(report.GetBroken()))
+	for _, b := range report.GetBroken() {
+		problem := "not crawled"
+		switch {
+		case len(b.GetError()) > 0:
+			problem = b.GetError()
+		case b.GetCrawled():
+			problem = fmt.Sprint(b.GetStatus())
+		}
+		fmt.Printf("  %s\n    canonical %s %s\n", b.GetUrl(), b.GetCanonical(), problem)
+	}
+
+	fmt.Printf("\nHreflang clusters missing return links (%d):\n", len(report.GetHreflangClusters()))
+	for i, cluster := range report.GetHreflangClusters() {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		for _, p := range cluster.GetPages() {
+			fmt.Printf("  %-12s %s", strings.Join(p.GetLangs(), ","), p.GetUrl())
+			if !p.GetCrawled() {
+				fmt.Print(" (not crawled)")
+			}
+			fmt.Println()
+		}
+
+		for _, m := range cluster.GetMissing() {
+			fmt.Printf("    %s doesn't link back to %s\n", m.GetTo(), m.GetFrom())
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+	"github.com/xlab/treeprint"
+)
+
+// ANSI escape codes used to color the diff.
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// printDiff prints the diff as a tree with each node marked with how it
+// changed, followed by the details of each changed page.
+func printDiff(diff *crawler.DiffResponse, color bool) {
+	fmt.Printf("Comparing crawl %s to %s\n", diff.GetFromId(), diff.GetToId())
+
+	tree := treeprint.New()
+	tree.SetValue(diffLabel(diff.GetTree(), color))
+	for _, child := range diff.GetTree().GetChildren() {
+		addDiffBranch(tree, child, color)
+	}
+	fmt.Println(tree.String())
+
+	for _, c := range diff.GetChanges() {
+		fmt.Println(colorize(changeMarker(c.GetChange())+" "+c.GetPath()+changeDetails(c), c.GetChange(), color))
+	}
+}
+
+// addDiffBranch adds the crawler.DiffTree as a branch to the printable tree.
+func addDiffBranch(tree treeprint.Tree, subTree *crawler.DiffTree, color bool) {
+	branch := tree.AddBranch(diffLabel(subTree, color))
+	for _, child := range subTree.GetChildren() {
+		addDiffBranch(branch, child, color)
+	}
+}
+
+// diffLabel prefixes the node's name with a marker for how it changed.
+func diffLabel(t *crawler.DiffTree, color bool) string {
+	return colorize(changeMarker(t.GetChange())+" "+t.GetName(), t.GetChange(), color)
+}
+
+// changeDetails describes the fields that changed on a changed page.
+func changeDetails(c *crawler.PageChange) string {
+	if c.GetChange() != crawler.ChangeType_CHANGED {
+		return ""
+	}
+
+	details := make([]string, 0, len(c.GetFields()))
+	for _, field := range c.GetFields() {
+		switch field {
+		case "status":
+			details = append(details, fmt.Sprintf("status %d -> %d", c.GetFrom().GetStatus(), c.GetTo().GetStatus()))
+		case "title":
+			details = append(details, fmt.Sprintf("title %q -> %q", c.GetFrom().GetTitle(), c.GetTo().GetTitle()))
+		default:
+			details = append(details, field)
+		}
+	}
+
+	return ": " + strings.Join(details, ", ")
+}
+
+func changeMarker(change crawler.ChangeType) string {
+	switch change {
+	case crawler.ChangeType_ADDED:
+		return "+"
+	case crawler.ChangeType_REMOVED:
+		return "-"
+	case crawler.ChangeType_CHANGED:
+		return "~"
+	default:
+		return " "
+	}
+}
+
+// colorize colors the text by how it changed if color is true.
+func colorize(text string, change crawler.ChangeType, color bool) string {
+	if !color {
+		return text
+	}
+
+	switch change {
+	case crawler.ChangeType_ADDED:
+		return colorGreen + text + colorReset
+	case crawler.ChangeType_REMOVED:
+		return colorRed + text + colorReset
+	case crawler.ChangeType_CHANGED:
+		return colorYellow + text + colorReset
+	default:
+		return text
+	}
+}
+
+// useColor reports whether stdout is a terminal and the NO_COLOR environment
+// variable isn't set.
+func useColor() bool {
+	if _, set := os.LookupEnv("NO_COLOR"); set {
+		return false
+	}
+
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
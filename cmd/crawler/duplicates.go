@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// printDuplicateReport prints each cluster of duplicate pages, with the number
+// of bits by which each page's SimHash differs from the first page's.
+func printDuplicateReport(report *crawler.DuplicateReportResponse) {
+	var duplicates int
+	for _, c := range report.GetClusters() {
+		duplicates += len(c.GetPages()) - 1
+	}
+	fmt.Printf("crawl %s: compared %s, found %s in %s\n", report.GetId(),
+		plural(int(report.GetPages()), "page"), plural(duplicates, "duplicate"), plural(len(report.GetClusters()), "cluster"))
+
+	for i, c := range report.GetClusters() {
+		if i == maxReportRows {
+			fmt.Printf("\n... and %d more clusters\n", len(report.GetClusters())-i)
+			break
+		}
+
+		kind := "Near duplicates"
+		if c.GetExact() {
+			kind = "Exact duplicates"
+		}
+		fmt.Printf("\n%s (%s):\n", kind, plural(len(c.GetPages()), "page"))
+
+		for j, p := range c.GetPages() {
+			fmt.Print("  " + p.GetUrl())
+			if j > 0 && !c.GetExact() {
+				fmt.Printf(" (distance %d)", p.GetDistance())
+			}
+			if p.GetSkipped() {
+				fmt.Print(" (links not followed)")
+			}
+			fmt.Println()
+		}
+	}
+}
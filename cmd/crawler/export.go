@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// writeExport writes the exported files received from the stream to dir and
+// prints their paths. The chunks of each file are appended to it in the order
+// they're received. Errors receiving the files are returned as they are, so
+// that they can be told apart from errors writing them by their gRPC status.
+func writeExport(dir string, stream crawler.Crawler_ExportClient) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var (
+		name string
+		file *os.File
+	)
+	closeFile := func() error {
+		if file == nil {
+			return nil
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		fmt.Println(file.Name())
+		file = nil
+		return nil
+	}
+	defer func() {
+		if file != nil {
+			file.Close()
+		}
+	}()
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return closeFile()
+		}
+		if err != nil {
+			return err
+		}
+
+		chunk := resp.GetFile()
+		if file == nil || chunk.GetName() != name {
+			if err := closeFile(); err != nil {
+				return err
+			}
+
+			// Only use the base of the name so that the service can't write
+			// outside of dir.
+			name = chunk.GetName()
+			file, err = os.Create(filepath.Join(dir, filepath.Base(name)))
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := file.Write(chunk.GetContent()); err != nil {
+			return err
+		}
+	}
+}
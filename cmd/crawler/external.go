@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// maxLinkSources is the most pages printed for each broken link.
+const maxLinkSources = 3
+
+// printExternalLinkReport prints the domains that are linked to and the broken
+// external links.
+func printExternalLinkReport(report *crawler.ExternalLinkReportResponse) {
+	fmt.Printf("crawl %s: %d external links to %d domains\n", report.GetId(), len(report.GetLinks()), len(report.GetDomains()))
+
+	fmt.Println("\nDomains:")
+	for i, d := range report.GetDomains() {
+		if i == maxReportRows {
+			fmt.Printf("  ... and %d more\n", len(report.GetDomains())-i)
+			break
+		}
+
+		fmt.Printf("  %-9s %-10s %s", plural(int(d.GetPages()), "page"), plural(int(d.GetLinks()), "link"), d.GetDomain())
+		if d.GetBroken() > 0 {
+			fmt.Printf(" (%d broken)", d.GetBroken())
+		}
+		fmt.Println()
+	}
+
+	if !report.GetChecked() {
+		fmt.Println("\nThe links weren't checked, start the crawl with -check-external-links to find broken links.")
+		return
+	}
+
+	var broken, unchecked int
+	for _, l := range report.GetLinks() {
+		if !l.GetChecked() {
+			unchecked++
+		}
+		if l.GetBroken() {
+			broken++
+		}
+	}
+
+	fmt.Printf("\nBroken links (%d):\n", broken)
+	for _, l := range report.GetLinks() {
+		if !l.GetBroken() {
+			continue
+		}
+
+		problem := l.GetError()
+		if len(problem) == 0 {
+			problem = fmt.Sprint(l.GetStatus())
+		}
+		fmt.Printf("  %s %s\n", l.GetUrl(), problem)
+
+		for i, page := range l.GetPages() {
+			if i == maxLinkSources {
+				fmt.Printf("    ... and %d more pages\n", len(l.GetPages())-i)
+				break
+			}
+			fmt.Println("    linked from " + page)
+		}
+	}
+
+	if unchecked > 0 {
+		fmt.Printf("\n%d links weren't checked because the crawl was stopped.\n", unchecked)
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// listFormats are the formats that -list can print, and whether they need the
+// metadata of each page.
+var listFormats = map[string]bool{
+	"tree":     false,
+	"json":     true,
+	"yaml":     true,
+	"csv":      true,
+	"urls":     true,
+	"markdown": false,
+	"html":     false,
+}
+
+// writeSiteTrees writes the site trees to w in the given format.
+func writeSiteTrees(w io.Writer, format string, siteTrees []*crawler.SiteTree) error {
+	switch format {
+	case "json", "yaml":
+		// JSON is valid YAML, so both formats are written as JSON.
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(sitesOutput(siteTrees))
+	case "csv":
+		return writeCSV(w, siteTrees)
+	case "urls":
+		for _, site := range siteTrees {
+			for _, page := range site.GetPages() {
+				if _, err := fmt.Fprintln(w, page.GetUrl()); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case "markdown":
+		return writeMarkdown(w, siteTrees)
+	case "html":
+		return writeHTML(w, siteTrees)
+	default:
+		printSiteTrees(siteTrees)
+		return nil
+	}
+}
+
+// siteOutput is how a site tree is represented in the json and yaml formats.
+type siteOutput struct {
+	URL   string       `json:"url"`
+	ID    string       `json:"id"`
+	Stats statsOutput  `json:"stats"`
+	Tree  *treeOutput  `json:"tree"`
+	Pages []pageOutput `json:"pages"`
+
+	Skipped []skippedOutput `json:"skipped,omitempty"`
+}
+
+type statsOutput struct {
+	Pages     int32 `json:"pages"`
+	Unchanged int32 `json:"unchanged"`
+	Failed    int32 `json:"failed"`
+	Skipped   int32 `json:"skipped"`
+}
+
+type skippedOutput struct {
+	URL    string `json:"url"`
+	Reason string `json:"reason"`
+}
+
+type treeOutput struct {
+	Name     string        `json:"name"`
+	NoIndex  bool          `json:"noindex,omitempty"`
+	Children []*treeOutput `json:"children,omitempty"`
+}
+
+type pageOutput struct {
+	URL          string    `json:"url"`
+	Status       int32     `json:"status"`
+	Error        string    `json:"error,omitempty"`
+	ContentType  string    `json:"content_type,omitempty"`
+	Title        string    `json:"title,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Canonical    string    `json:"canonical,omitempty"`
+	NoIndex      bool      `json:"noindex,omitempty"`
+	ContentHash  string    `json:"content_hash,omitempty"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	NotModified  bool      `json:"not_modified,omitempty"`
+	FetchedAt    time.Time `json:"fetched_at"`
+	Links        []string  `json:"links,omitempty"`
+	External     []string  `json:"external_links,omitempty"`
+}
+
+func sitesOutput(siteTrees []*crawler.SiteTree) []siteOutput {
+	sites := make([]siteOutput, 0, len(siteTrees))
+	for _, site := range siteTrees {
+		out := siteOutput{
+			URL: site.GetUrl(),
+			ID:  site.GetId(),
+			Stats: statsOutput{
+				Pages:     site.GetStats().GetPages(),
+				Unchanged: site.GetStats().GetUnchanged(),
+				Failed:    site.GetStats().GetFailed(),
+				Skipped:   site.GetStats().GetSkipped(),
+			},
+			Tree:  treeToOutput(site.GetTree()),
+			Pages: make([]pageOutput, 0, len(site.GetPages())),
+		}
+
+		for _, p := range site.GetPages() {
+			fetchedAt, _ := ptypes.Timestamp(p.GetFetchedAt())
+			out.Pages = append(out.Pages, pageOutput{
+				URL:          p.GetUrl(),
+				Status:       p.GetStatus(),
+				Error:        p.GetError(),
+				ContentType:  p.GetContentType(),
+				Title:        p.GetTitle(),
+				Description:  p.GetDescription(),
+				Canonical:    p.GetCanonical(),
+				NoIndex:      p.GetNoindex(),
+				ContentHash:  p.GetContentHash(),
+				ETag:         p.GetEtag(),
+				LastModified: p.GetLastModified(),
+				NotModified:  p.GetNotModified(),
+				FetchedAt:    fetchedAt,
+				Links:        p.GetLinks(),
+				External:     p.GetExternalLinks(),
+			})
+		}
+
+		for _, skipped := range site.GetSkipped() {
+			out.Skipped = append(out.Skipped, skippedOutput{URL: skipped.GetUrl(), Reason: skipped.GetReason()})
+		}
+
+		sites = append(sites, out)
+	}
+
+	return sites
+}
+
+func treeToOutput(t *crawler.Tree) *treeOutput {
+	out := &treeOutput{Name: t.GetName(), NoIndex: t.GetNoindex()}
+	for _, child := range t.GetChildren() {
+		out.Children = append(out.Children, treeToOutput(child))
+	}
+
+	return out
+}
+
+// writeCSV writes one row per page with the page's metadata.
+func writeCSV(w io.Writer, siteTrees []*crawler.SiteTree) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"site", "url", "status", "content_type", "title", "content_hash",
+		"etag", "last_modified", "not_modified", "fetched_at", "links", "error",
+	})
+
+	for _, site := range siteTrees {
+		for _, p := range site.GetPages() {
+			fetchedAt, _ := ptypes.Timestamp(p.GetFetchedAt())
+			cw.Write([]string{
+				site.GetUrl(),
+				p.GetUrl(),
+				strconv.Itoa(int(p.GetStatus())),
+				p.GetContentType(),
+				p.GetTitle(),
+				p.GetContentHash(),
+				p.GetEtag(),
+				p.GetLastModified(),
+				strconv.FormatBool(p.GetNotModified()),
+				fetchedAt.Format(time.RFC3339),
+				strconv.Itoa(len(p.GetLinks())),
+				p.GetError(),
+			})
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeMarkdown writes each site tree as a heading followed by a nested list.
+func writeMarkdown(w io.Writer, siteTrees []*crawler.SiteTree) error {
+	var sb strings.Builder
+	for i, site := range siteTrees {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		fmt.Fprintf(&sb, "# %s\n\n", site.GetUrl())
+		writeMarkdownTree(&sb, site.GetTree(), 0)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMarkdownTree(sb *strings.Builder, t *crawler.Tree, depth int) {
+	fmt.Fprintf(sb, "%s- %s\n", strings.Repeat("  ", depth), treeLabel(t))
+	for _, child := range t.GetChildren() {
+		writeMarkdownTree(sb, child, depth+1)
+	}
+}
+
+// writeHTML writes a standalone HTML document with each site tree as a nested
+// list.
+func writeHTML(w io.Writer, siteTrees []*crawler.SiteTree) error {
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>Site trees</title>\n</head>\n<body>\n")
+	for _, site := range siteTrees {
+		fmt.Fprintf(&sb, "<h1>%s</h1>\n<ul>\n", html.EscapeString(site.GetUrl()))
+		writeHTMLTree(&sb, site.GetTree())
+		sb.WriteString("</ul>\n")
+	}
+	sb.WriteString("</body>\n</html>\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeHTMLTree(sb *strings.Builder, t *crawler.Tree) {
+	sb.WriteString("<li>" + html.EscapeString(treeLabel(t)))
+	if len(t.GetChildren()) > 0 {
+		sb.WriteString("\n<ul>\n")
+		for _, child := range t.GetChildren() {
+			writeHTMLTree(sb, child)
+		}
+		sb.WriteString("</ul>\n")
+	}
+	sb.WriteString("</li>\n")
+}
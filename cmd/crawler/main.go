@@ -7,17 +7,20 @@ import (
 	"os"
 	"os/signal"
 	"sort"
+	"strings"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/wrrn/crawler/pkg/crawler"
 	"github.com/xlab/treeprint"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 var (
 	errTooManyCommands = fmt.Errorf("Too many commands used. Use one of the following command flags: %v", commandNames)
 	errNoCommand       = fmt.Errorf("No command used. Use one of the following command flags: %v", commandNames)
-	commands           = map[string]bool{"start": true, "stop": true, "list": true}
-	commandNames       = []string{"-start", "-stop", "-list"}
+	commands           = map[string]bool{"start": true, "stop": true, "list": true, "delete": true, "schedule": true, "schedules": true, "unschedule": true, "diff": true, "sitemap-report": true, "export": true, "asset-report": true, "external-report": true, "audit": true, "canonical-report": true, "duplicate-report": true, "structured-data": true, "results": true, "search": true}
+	commandNames       = []string{"-start", "-stop", "-list", "-delete", "-schedule", "-schedules", "-unschedule", "-diff", "-sitemap-report", "-export", "-asset-report", "-external-report", "-audit", "-canonical-report", "-duplicate-report", "-structured-data", "-results", "-search"}
 )
 
 func main() {
@@ -26,8 +29,80 @@ func main() {
 		startURL   = flag.String("start", "", "the url to start crawling")
 		stopURL    = flag.String("stop", "", "the url to stop crawling")
 		list       = flag.Bool("list", false, "show the current site tree for all crawled URLs")
+		listFormat = flag.String("format", "tree", "the format -list prints the site trees in: tree, json, yaml, csv, urls, markdown or html")
+		deleteURL  = flag.String("delete", "", "the url to delete the stored site trees for")
+		priority   = flag.Int("priority", 1, "the crawl's share of the service's fetches relative to other crawls, used with -start and -schedule")
+		fullCrawl  = flag.Bool("full-recrawl", false, "fetch every page again instead of only the pages modified since the previous crawl, used with -start and -schedule")
+		sitemaps   = flag.Bool("sitemaps", false, "seed the crawl with the pages in the site's sitemaps, used with -start and -schedule")
+		archive    = flag.Bool("archive", false, "archive the crawl's requests and responses to WARC files on the service, used with -start and -schedule")
+		mirror     = flag.Bool("mirror", false, "save the crawl's pages and assets on the service for offline browsing, used with -start and -schedule")
+		mirrorMax  = flag.Int64("mirror-max-size", 0, "the most bytes saved by -mirror, 0 uses the service's limit")
+		mirrorFile = flag.Int64("mirror-max-file-size", 0, "the largest file in bytes saved by -mirror, 0 uses the service's limit")
+		checkAsset = flag.Bool("check-assets", false, "request the assets used by the pages to find their status and size, used with -start and -schedule")
+		checkLinks = flag.Bool("check-external-links", false, "request the links to other sites once the crawl has finished, used with -start and -schedule")
+		linkRate   = flag.Float64("external-rate", 0, "the most external links checked each second by -check-external-links, 0 uses the service's default")
+		nofollow   = flag.String("nofollow", "ignore", "the nofollow directives respected when following links, used with -start and -schedule: ignore, pages (robots meta tags and X-Robots-Tag headers) or all (rel=\"nofollow\" as well)")
+		canonical  = flag.Bool("canonical-tree", false, "build the site tree from the pages' canonical URLs, collapsing duplicate pages, used with -start and -schedule")
+		skipDups   = flag.Bool("skip-duplicates", false, "don't follow the links of pages with the same or nearly the same text as an earlier page, used with -start and -schedule")
+		dupDist    = flag.Int("duplicate-distance", 0, "the most bits by which the SimHashes of near duplicate pages differ, used with -skip-duplicates and -duplicate-report, 0 uses the service's default")
+		allowTraps = flag.Bool("allow-traps", false, "crawl URLs that look like crawler traps instead of skipping them, used with -start and -schedule")
+		trapDepth  = flag.Int("trap-max-depth", 0, "the most segments a path may have before it is skipped as a trap, 0 uses the service's default")
+		trapRepeat = flag.Int("trap-max-repeats", 0, "the most times a segment may appear in a path before it is skipped as a trap, 0 uses the service's default")
+		trapQuery  = flag.Int("trap-max-variants", 0, "the most query strings that differ in more than their numbers a path may be seen with before the rest are skipped as a trap, 0 uses the service's default")
+		trapPages  = flag.Int("trap-max-pages", 0, "the longest chain of URLs that differ only in their numbers, each found on the page before it, that is crawled before the rest are skipped as a trap, 0 uses the service's default")
+		rulesFile  = flag.String("rules", "", "a JSON file with an array of extraction rules, each with a name, selector, attribute and url_pattern, used with -start and -schedule")
+		replay     = flag.String("replay", "", "the ID of an archived crawl to fetch the pages from instead of the network, used with -start and -schedule")
+		replayWARC = flag.String("replay-files", "", "a comma separated list of WARC files or directories, relative to the service's -warc-dir, to fetch the pages from instead of the network, used with -start and -schedule")
+
+		scheduleURL   = flag.String("schedule", "", "the url to crawl on a schedule given by -cron or -every")
+		cronExpr      = flag.String("cron", "", "the cron expression used with -schedule, e.g. \"0 3 * * mon\"")
+		every         = flag.Duration("every", 0, "the interval used with -schedule, e.g. 168h")
+		listSchedules = flag.Bool("schedules", false, "show the crawl schedules and their recent runs")
+		unscheduleID  = flag.String("unschedule", "", "the ID of the schedule to delete")
+
+		diffURL = flag.String("diff", "", "the url to compare the two most recent crawls of, or the crawls given by -from and -to")
+		fromID  = flag.String("from", "", "the ID of the older crawl used with -diff")
+		toID    = flag.String("to", "", "the ID of the newer crawl used with -diff")
+		noColor = flag.Bool("no-color", false, "don't color the output of -diff")
+
+		sitemapReportURL = flag.String("sitemap-report", "", "the url to compare the sitemap and linked pages of")
+		externalURL      = flag.String("external-report", "", "the url to report the links to other sites and broken external links of")
+		assetReportURL   = flag.String("asset-report", "", "the url to report the asset usage, broken assets and page weight of")
+		canonicalURL     = flag.String("canonical-report", "", "the url to report the canonical chains, broken canonicals and hreflang clusters missing return links of")
+		duplicateURL     = flag.String("duplicate-report", "", "the url to report the clusters of duplicate and near duplicate pages of")
+		structuredURL    = flag.String("structured-data", "", "the url to show the JSON-LD, Microdata, OpenGraph and Twitter card metadata of")
+		dataType         = flag.String("type", "", "only show the pages with a JSON-LD object or Microdata item of the given type with -structured-data, e.g. Product")
+		resultsURL       = flag.String("results", "", "the url to write the records extracted by the crawl's rules of")
+		resultsFormat    = flag.String("results-format", "jsonl", "the format -results writes the records in: jsonl or csv")
+		searchQuery      = flag.String("search", "", "the words or \"quoted phrases\" to search the crawl of -site for")
+		searchLimit      = flag.Int("search-limit", 0, "the most pages -search shows, 0 uses the service's default")
+		auditURL         = flag.String("audit", "", "the url to audit for SEO problems like missing titles and thin content")
+		maxURLLength     = flag.Int("max-url-length", 0, "the length above which -audit reports a URL as too long, 0 uses the service's default")
+		minWords         = flag.Int("min-words", 0, "the number of words below which -audit reports a page as thin, 0 uses the service's default")
+		crawlID          = flag.String("id", "", "the ID of the crawl to report on, defaults to the most recent crawl of the url")
+
+		exportFormat     = flag.String("export", "", "the format to export the crawl of -site to: sitemap, dot, graphml, mermaid or jsonl")
+		siteURL          = flag.String("site", "", "the url of the crawl to export or search")
+		outDir           = flag.String("out", ".", "the directory to write the exported files to")
+		baseURL          = flag.String("base-url", "", "the url the exported files will be served from, defaults to the root of -site")
+		maxDepth         = flag.Int("max-depth", 0, "the deepest level of the site tree drawn by the graph exports, 0 draws every level")
+		collapseSiblings = flag.Int("collapse-siblings", 0, "the most children a node is drawn with by the graph exports, the rest are collapsed into one node")
+		colorByStatus    = flag.Bool("color-by-status", false, "color the nodes of the graph exports by their HTTP status")
+		graphLinks       = flag.Bool("links", false, "draw the links between pages in the graph exports as well as the site tree")
+
+		useTLS        = flag.Bool("tls", false, "connect to the crawler-service using TLS")
+		tlsCA         = flag.String("tls-ca", "", "the PEM encoded CA bundle used to verify the service, defaults to the system roots")
+		tlsCert       = flag.String("tls-cert", "", "the PEM encoded client certificate, used for mutual TLS")
+		tlsKey        = flag.String("tls-key", "", "the PEM encoded private key for -tls-cert")
+		tlsServerName = flag.String("tls-server-name", "", "overrides the server name used to verify the service's certificate")
+
+		token         = flag.String("token", os.Getenv("CRAWLER_TOKEN"), "the bearer token used to authenticate with the crawler-service over TLS, defaults to $CRAWLER_TOKEN")
+		insecureToken = flag.Bool("insecure-token", false, "send -token without TLS, only allowed when -service-addr is a loopback address")
 	)
 
+	var rules ruleFlags
+	flag.Var(&rules, "rule", "an extraction rule of the form name=selector or name=selector@attribute, used with -start and -schedule, may be repeated")
+
 	flag.Parse()
 
 	if err := validateFlags(); err != nil {
@@ -37,9 +112,31 @@ func main() {
 		// We don't need to return here because os.Exit will handle that for us.
 	}
 
-	// TODO(wh): Use a tls cert
+	// Any of the TLS flags imply that we want to use TLS.
+	secure := *useTLS || len(*tlsCA) > 0 || len(*tlsCert) > 0 || len(*tlsKey) > 0 || len(*tlsServerName) > 0
+	dialOpts := []grpc.DialOption{grpc.WithInsecure()}
+	if secure {
+		creds, err := tlsDialOption(*tlsCA, *tlsCert, *tlsKey, *tlsServerName)
+		if err != nil {
+			exit(1, fmt.Sprintf("Failed to setup TLS: %v", err))
+		}
+		dialOpts = []grpc.DialOption{creds}
+	}
+
+	if len(*token) > 0 {
+		// Refuse to send the token in cleartext, unless it was asked for
+		// and it won't leave the machine.
+		if !secure && !*insecureToken {
+			exit(1, "The token is only sent over TLS, use -tls or, for a service on this machine, -insecure-token")
+		}
+		if !secure && !isLoopback(*serverAddr) {
+			exit(1, fmt.Sprintf("-insecure-token can only be used with a loopback -service-addr, not %s", *serverAddr))
+		}
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(bearerToken{token: *token, insecure: !secure}))
+	}
+
 	//	Create the client
-	conn, err := grpc.Dial(*serverAddr, grpc.WithInsecure())
+	conn, err := grpc.Dial(*serverAddr, dialOpts...)
 	if err != nil {
 		exit(2, fmt.Sprintf("Failed to dial %s: %v\n", *serverAddr, err))
 		// We don't need to return here because exit will handle that for us.
@@ -59,25 +156,82 @@ func main() {
 		cancel()
 	}()
 
+	nofollowPolicy, ok := crawler.NofollowPolicy_value["NOFOLLOW_"+strings.ToUpper(*nofollow)]
+	if !ok {
+		exit(1, fmt.Sprintf("Unknown nofollow policy %s", *nofollow))
+	}
+
+	if len(*rulesFile) > 0 {
+		fileRules, err := readRules(*rulesFile)
+		if err != nil {
+			exit(1, err.Error())
+		}
+		rules = append(rules, fileRules...)
+	}
+
+	// The options for the crawls made by -start and -schedule.
+	crawlOptions := &crawler.CrawlOptions{
+		Priority:      int32(*priority),
+		FullRecrawl:   *fullCrawl,
+		UseSitemaps:   *sitemaps,
+		Archive:       *archive,
+		Replay:        *replay,
+		ReplayFiles:   splitList(*replayWARC),
+		CheckAssets:   *checkAsset,
+		Nofollow:      crawler.NofollowPolicy(nofollowPolicy),
+		CanonicalTree: *canonical,
+		Rules:         rules,
+
+		SkipDuplicates:    *skipDups,
+		DuplicateDistance: int32(*dupDist),
+
+		CheckExternalLinks:     *checkLinks,
+		ExternalLinksPerSecond: *linkRate,
+
+		Traps: &crawler.TrapOptions{
+			Disabled:         *allowTraps,
+			MaxDepth:         int32(*trapDepth),
+			MaxRepeats:       int32(*trapRepeat),
+			MaxQueryVariants: int32(*trapQuery),
+			MaxPages:         int32(*trapPages),
+		},
+	}
+	if *mirror {
+		crawlOptions.Mirror = &crawler.MirrorOptions{MaxTotalSize: *mirrorMax, MaxFileSize: *mirrorFile}
+	}
+
 	// Send the information over the wire
 	// TODO(wh): Is there better way to handle this than with a switch statement.
 	switch {
 	case len(*startURL) > 0:
-		// We don't care about the output of Start because it returns an empty response.
-		_, err := client.Start(ctx, &crawler.StartRequest{Url: *startURL})
+		startResponse, err := client.Start(ctx, &crawler.StartRequest{
+			Url:     *startURL,
+			Options: crawlOptions,
+		})
 		if err != nil {
 			exit(3, fmt.Sprintf("Failed to send the start request to %s: %v", *serverAddr, err))
 		}
 
+		if startResponse.GetQueued() {
+			fmt.Printf("Queued crawl %s of %s\n", startResponse.GetId(), *startURL)
+		} else {
+			fmt.Printf("Started crawl %s of %s\n", startResponse.GetId(), *startURL)
+		}
+
 	case len(*stopURL) > 0:
 		// We don't care about the output of Stop because it returns an empty response.
 		_, err := client.Stop(ctx, &crawler.StopRequest{Url: *stopURL})
 		if err != nil {
-			exit(3, fmt.Sprint("Failed to send the stop request to %s: %v", *serverAddr, err))
+			exit(3, fmt.Sprintf("Failed to send the stop request to %s: %v", *serverAddr, err))
 		}
 
 	case *list:
-		listResponse, err := client.List(ctx, &crawler.ListRequest{})
+		needsPages, found := listFormats[*listFormat]
+		if !found {
+			exit(1, fmt.Sprintf("Unknown list format %q", *listFormat))
+		}
+
+		listResponse, err := client.List(ctx, &crawler.ListRequest{IncludePages: needsPages})
 		if err != nil {
 			exit(3, fmt.Sprintf("Failed to send the list request to %s: %v", *serverAddr, err))
 		}
@@ -87,12 +241,181 @@ func main() {
 		sort.Slice(siteTrees, func(i, j int) bool {
 			return siteTrees[i].GetUrl() < siteTrees[j].GetUrl()
 		})
-		printSiteTrees(siteTrees)
+		if err := writeSiteTrees(os.Stdout, *listFormat, siteTrees); err != nil {
+			exit(4, fmt.Sprintf("Failed to write the site trees: %v", err))
+		}
+
+	case len(*deleteURL) > 0:
+		deleteResponse, err := client.Delete(ctx, &crawler.DeleteRequest{Url: *deleteURL})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the delete request to %s: %v", *serverAddr, err))
+		}
+
+		fmt.Printf("Deleted %d site trees for %s\n", deleteResponse.GetDeleted(), *deleteURL)
+
+	case len(*scheduleURL) > 0:
+		req := &crawler.CreateScheduleRequest{
+			Url:     *scheduleURL,
+			Cron:    *cronExpr,
+			Options: crawlOptions,
+		}
+		if *every > 0 {
+			req.Interval = ptypes.DurationProto(*every)
+		}
+
+		scheduleResponse, err := client.CreateSchedule(ctx, req)
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the schedule request to %s: %v", *serverAddr, err))
+		}
+
+		printSchedules([]*crawler.Schedule{scheduleResponse.GetSchedule()})
+
+	case *listSchedules:
+		schedulesResponse, err := client.ListSchedules(ctx, &crawler.ListSchedulesRequest{})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the schedules request to %s: %v", *serverAddr, err))
+		}
+
+		printSchedules(schedulesResponse.GetSchedules())
+
+	case len(*unscheduleID) > 0:
+		// We don't care about the output of DeleteSchedule because it returns an empty response.
+		_, err := client.DeleteSchedule(ctx, &crawler.DeleteScheduleRequest{Id: *unscheduleID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the unschedule request to %s: %v", *serverAddr, err))
+		}
+
+	case len(*diffURL) > 0:
+		diffResponse, err := client.Diff(ctx, &crawler.DiffRequest{Url: *diffURL, FromId: *fromID, ToId: *toID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the diff request to %s: %v", *serverAddr, err))
+		}
+
+		printDiff(diffResponse, !*noColor && useColor())
+
+	case len(*sitemapReportURL) > 0:
+		report, err := client.SitemapReport(ctx, &crawler.SitemapReportRequest{Url: *sitemapReportURL, Id: *crawlID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the sitemap report request to %s: %v", *serverAddr, err))
+		}
+
+		printSitemapReport(report)
+
+	case len(*assetReportURL) > 0:
+		report, err := client.AssetReport(ctx, &crawler.AssetReportRequest{Url: *assetReportURL, Id: *crawlID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the asset report request to %s: %v", *serverAddr, err))
+		}
+
+		printAssetReport(report)
+
+	case len(*externalURL) > 0:
+		report, err := client.ExternalLinkReport(ctx, &crawler.ExternalLinkReportRequest{Url: *externalURL, Id: *crawlID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the external link report request to %s: %v", *serverAddr, err))
+		}
+
+		printExternalLinkReport(report)
+
+	case len(*auditURL) > 0:
+		report, err := client.Audit(ctx, &crawler.AuditRequest{
+			Url:          *auditURL,
+			Id:           *crawlID,
+			MaxUrlLength: int32(*maxURLLength),
+			MinWords:     int32(*minWords),
+		})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the audit request to %s: %v", *serverAddr, err))
+		}
+
+		printAuditReport(report)
+
+	case len(*canonicalURL) > 0:
+		report, err := client.CanonicalReport(ctx, &crawler.CanonicalReportRequest{Url: *canonicalURL, Id: *crawlID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the canonical report request to %s: %v", *serverAddr, err))
+		}
+
+		printCanonicalReport(report)
+
+	case len(*duplicateURL) > 0:
+		report, err := client.DuplicateReport(ctx, &crawler.DuplicateReportRequest{Url: *duplicateURL, Id: *crawlID, MaxDistance: int32(*dupDist)})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the duplicate report request to %s: %v", *serverAddr, err))
+		}
+
+		printDuplicateReport(report)
+
+	case len(*structuredURL) > 0:
+		data, err := client.StructuredData(ctx, &crawler.StructuredDataRequest{Url: *structuredURL, Id: *crawlID, Type: *dataType})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the structured data request to %s: %v", *serverAddr, err))
+		}
+
+		printStructuredData(data)
+
+	case len(*searchQuery) > 0:
+		if len(*siteURL) == 0 {
+			exit(1, "-search needs the url of the crawl to search given by -site")
+		}
+
+		resp, err := client.Search(ctx, &crawler.SearchRequest{Url: *siteURL, Id: *crawlID, Query: *searchQuery, Limit: int32(*searchLimit)})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the search request to %s: %v", *serverAddr, err))
+		}
+
+		printSearchResults(*searchQuery, resp)
+
+	case len(*resultsURL) > 0:
+		if !resultsFormats[*resultsFormat] {
+			exit(1, fmt.Sprintf("Unknown results format %q", *resultsFormat))
+		}
+
+		stream, err := client.Results(ctx, &crawler.ResultsRequest{Url: *resultsURL, Id: *crawlID})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the results request to %s: %v", *serverAddr, err))
+		}
+
+		if err := writeResults(os.Stdout, stream, *resultsFormat); err != nil {
+			if _, ok := status.FromError(err); ok {
+				exit(3, fmt.Sprintf("Failed to receive the results from %s: %v", *serverAddr, err))
+			}
+			exit(4, fmt.Sprintf("Failed to write the results: %v", err))
+		}
+
+	case len(*exportFormat) > 0:
+		format, ok := crawler.ExportFormat_value[strings.ToUpper(*exportFormat)]
+		if !ok || format == int32(crawler.ExportFormat_EXPORT_FORMAT_UNSPECIFIED) {
+			exit(1, fmt.Sprintf("Unknown export format %s", *exportFormat))
+		}
+
+		stream, err := client.Export(ctx, &crawler.ExportRequest{
+			Url:     *siteURL,
+			Id:      *crawlID,
+			Format:  crawler.ExportFormat(format),
+			BaseUrl: *baseURL,
+			Graph: &crawler.GraphOptions{
+				MaxDepth:         int32(*maxDepth),
+				CollapseSiblings: int32(*collapseSiblings),
+				ColorByStatus:    *colorByStatus,
+				Links:            *graphLinks,
+			},
+		})
+		if err != nil {
+			exit(3, fmt.Sprintf("Failed to send the export request to %s: %v", *serverAddr, err))
+		}
+
+		if err := writeExport(*outDir, stream); err != nil {
+			if _, ok := status.FromError(err); ok {
+				exit(3, fmt.Sprintf("Failed to receive the exported files from %s: %v", *serverAddr, err))
+			}
+			exit(4, fmt.Sprintf("Failed to write the exported files: %v", err))
+		}
 	}
 
 }
 
-// validateFlags returns an error a command (start,stop,list) wasn't passed in
+// validateFlags returns an error a command (start,stop,list,delete,...) wasn't passed in
 // via the command line or if multiple commands were passed in.
 func validateFlags() error {
 	var commandsSeen int8
@@ -113,22 +436,25 @@ func validateFlags() error {
 	return nil
 }
 
-// printSiteTrees will print an the siteTrees in alphanumeric order.
+// printSiteTrees will print an the siteTrees in alphanumeric order, each
+// followed by a summary of the crawl that produced it.
 func printSiteTrees(siteTrees []*crawler.SiteTree) {
-	trees := make([]treeprint.Tree, 0, len(siteTrees))
 	for _, site := range siteTrees {
-		trees = append(trees, buildTree(site.GetTree()))
-	}
+		fmt.Println(buildTree(site.GetTree()).String())
 
-	for _, tree := range trees {
-		fmt.Println(tree.String())
+		stats := site.GetStats()
+		fmt.Printf("crawl %s: %d pages, %d unchanged, %d failed", site.GetId(), stats.GetPages(), stats.GetUnchanged(), stats.GetFailed())
+		if stats.GetSkipped() > 0 {
+			fmt.Printf(", %d skipped as crawler traps", stats.GetSkipped())
+		}
+		fmt.Print("\n\n")
 	}
 }
 
 // buildTree converts a crawler.Tree to a printable tree.
 func buildTree(t *crawler.Tree) treeprint.Tree {
 	tree := treeprint.New()
-	tree.SetValue(t.GetName())
+	tree.SetValue(treeLabel(t))
 	for _, child := range t.GetChildren() {
 		addTreeBranch(tree, child)
 	}
@@ -138,14 +464,36 @@ func buildTree(t *crawler.Tree) treeprint.Tree {
 
 // addTreeBranch adds the crawler.Tree as a branch to the printable tree.
 func addTreeBranch(tree treeprint.Tree, subTree *crawler.Tree) {
-	branch := tree.AddBranch(subTree.GetName())
+	branch := tree.AddBranch(treeLabel(subTree))
 	for _, child := range subTree.GetChildren() {
 		addTreeBranch(branch, child)
 	}
 }
 
+// treeLabel returns the text a tree's node is printed with, which marks the
+// pages that ask not to be indexed.
+func treeLabel(t *crawler.Tree) string {
+	if t.GetNoindex() {
+		return t.GetName() + " (noindex)"
+	}
+
+	return t.GetName()
+}
+
 // exit is convenience for exiting an printing a message.
 func exit(code int, message string) {
 	fmt.Fprintln(os.Stderr, message)
 	os.Exit(code)
 }
+
+// splitList splits a comma separated list, dropping empty items.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// attributeName matches the names of HTML attributes.
+var attributeName = regexp.MustCompile(`^[A-Za-z_:][-A-Za-z0-9_:.]*$`)
+
+// ruleFlags collects the extraction rules given by repeated -rule flags, each
+// of the form name=selector or name=selector@attribute.
+type ruleFlags []*crawler.ExtractionRule
+
+func (r *ruleFlags) String() string {
+	rules := make([]string, 0, len(*r))
+	for _, rule := range *r {
+		rules = append(rules, rule.GetName()+"="+rule.GetSelector())
+	}
+
+	return strings.Join(rules, ",")
+}
+
+func (r *ruleFlags) Set(val string) error {
+	i := strings.Index(val, "=")
+	if i <= 0 || i == len(val)-1 {
+		return errors.Errorf("%q isn't of the form name=selector", val)
+	}
+
+	rule := &crawler.ExtractionRule{Name: val[:i], Selector: val[i+1:]}
+	if at := strings.LastIndex(rule.Selector, "@"); at >= 0 && attributeName.MatchString(rule.Selector[at+1:]) {
+		rule.Selector, rule.Attribute = rule.Selector[:at], rule.Selector[at+1:]
+	}
+
+	*r = append(*r, rule)
+	return nil
+}
+
+// readRules reads a JSON array of extraction rules from a file, with the same
+// fields as crawler.ExtractionRule.
+func readRules(path string) ([]*crawler.ExtractionRule, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+
+	var rules []struct {
+		Name       string `json:"name"`
+		Selector   string `json:"selector"`
+		Attribute  string `json:"attribute"`
+		URLPattern string `json:"url_pattern"`
+	}
+	if err := json.Unmarshal(b, &rules); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the rules in %s", path)
+	}
+
+	converted := make([]*crawler.ExtractionRule, 0, len(rules))
+	for _, r := range rules {
+		converted = append(converted, &crawler.ExtractionRule{
+			Name:       r.Name,
+			Selector:   r.Selector,
+			Attribute:  r.Attribute,
+			UrlPattern: r.URLPattern,
+		})
+	}
+
+	return converted, nil
+}
+
+// resultsFormats are the formats that -results can write the records in.
+var resultsFormats = map[string]bool{"jsonl": true, "csv": true}
+
+// writeResults writes the records received from the stream in the given
+// format. JSON Lines are written as they are received, but CSV needs every
+// field name for its header so the records are collected first. Errors
+// receiving the records are returned as they are, so that they can be told
+// apart from errors writing them by their gRPC status.
+func writeResults(w io.Writer, stream crawler.Crawler_ResultsClient, format string) error {
+	var records []*crawler.Record
+	enc := json.NewEncoder(w)
+	for {
+		record, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if format == "csv" {
+			records = append(records, record)
+			continue
+		}
+
+		if err := enc.Encode(recordOutput(record)); err != nil {
+			return err
+		}
+	}
+
+	if format == "csv" {
+		return writeResultsCSV(w, records)
+	}
+
+	return nil
+}
+
+// recordJSON is how a record is represented in the jsonl format.
+type recordJSON struct {
+	URL    string              `json:"url"`
+	Fields map[string][]string `json:"fields"`
+}
+
+func recordOutput(record *crawler.Record) recordJSON {
+	out := recordJSON{URL: record.GetUrl(), Fields: map[string][]string{}}
+	for _, f := range record.GetFields() {
+		out.Fields[f.GetName()] = append([]string{}, f.GetValues()...)
+	}
+
+	return out
+}
+
+// writeResultsCSV writes a row for each record with a column for each field,
+// in the order the fields were first seen. Several values of a field are
+// separated by "; ".
+func writeResultsCSV(w io.Writer, records []*crawler.Record) error {
+	var names []string
+	columns := map[string]int{}
+	for _, record := range records {
+		for _, f := range record.GetFields() {
+			if _, found := columns[f.GetName()]; !found {
+				columns[f.GetName()] = len(names) + 1
+				names = append(names, f.GetName())
+			}
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"url"}, names...)); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		row := make([]string, len(names)+1)
+		row[0] = record.GetUrl()
+		for _, f := range record.GetFields() {
+			row[columns[f.GetName()]] = strings.Join(f.GetValues(), "; ")
+		}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
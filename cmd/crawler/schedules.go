@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// printSchedules prints each schedule followed by its recent runs.
+func printSchedules(schedules []*crawler.Schedule) {
+	for _, s := range schedules {
+		when := fmt.Sprintf("cron %q", s.GetCron())
+		if s.GetInterval() != nil {
+			interval, _ := ptypes.Duration(s.GetInterval())
+			when = fmt.Sprintf("every %s", interval)
+		}
+
+		fmt.Printf("%s %s %s, next run at %s\n", s.GetId(), s.GetUrl(), when, formatTimestamp(s.GetNextRun()))
+		for _, r := range s.GetRuns() {
+			switch {
+			case r.GetSkipped():
+				fmt.Printf("  %s skipped, the previous crawl was still running\n", formatTimestamp(r.GetTime()))
+			case len(r.GetError()) > 0:
+				fmt.Printf("  %s failed: %s\n", formatTimestamp(r.GetTime()), r.GetError())
+			default:
+				fmt.Printf("  %s started crawl %s\n", formatTimestamp(r.GetTime()), r.GetJobId())
+			}
+		}
+	}
+}
+
+// formatTimestamp formats a protobuf timestamp in the local time zone.
+func formatTimestamp(ts *timestamp.Timestamp) string {
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return "never"
+	}
+
+	return t.Local().Format(time.RFC3339)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// printSearchResults prints each page that matched a search with its position
+// in the site tree and the text around the match.
+func printSearchResults(query string, resp *crawler.SearchResponse) {
+	fmt.Printf("crawl %s: %s matched %s", resp.GetId(), plural(int(resp.GetTotal()), "page"), query)
+	if int(resp.GetTotal()) > len(resp.GetResults()) {
+		fmt.Printf(", showing the first %d", len(resp.GetResults()))
+	}
+	fmt.Println()
+
+	for _, r := range resp.GetResults() {
+		fmt.Println()
+		fmt.Print(r.GetUrl())
+		if len(r.GetTitle()) > 0 {
+			fmt.Printf(" - %s", r.GetTitle())
+		}
+		fmt.Println()
+
+		if len(r.GetTreePath()) > 0 {
+			fmt.Printf("  %s\n", strings.Join(r.GetTreePath(), " > "))
+		}
+		fmt.Printf("  %s\n", r.GetSnippet())
+	}
+}
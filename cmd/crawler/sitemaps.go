@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// printSitemapReport prints the pages that are only in the sitemaps and the
+// pages that are only reachable by links.
+func printSitemapReport(report *crawler.SitemapReportResponse) {
+	fmt.Printf("crawl %s: %d URLs in the sitemaps\n", report.GetId(), report.GetSitemapUrls())
+
+	fmt.Printf("\nIn the sitemaps but not linked to (%d):\n", len(report.GetSitemapOnly()))
+	for _, u := range report.GetSitemapOnly() {
+		fmt.Println("  " + u)
+	}
+
+	fmt.Printf("\nLinked to but not in the sitemaps (%d):\n", len(report.GetLinksOnly()))
+	for _, u := range report.GetLinksOnly() {
+		fmt.Println("  " + u)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wrrn/crawler/pkg/crawler"
+)
+
+// printStructuredData prints a summary of the structured data of each page:
+// the types of its JSON-LD and Microdata, any invalid JSON-LD, and its
+// OpenGraph and Twitter card tags.
+func printStructuredData(data *crawler.StructuredDataResponse) {
+	var invalid int
+	for _, p := range data.GetPages() {
+		for _, ld := range p.GetData().GetJsonLd() {
+			if len(ld.GetError()) > 0 {
+				invalid++
+			}
+		}
+	}
+	fmt.Printf("crawl %s: %s with structured data, %s\n", data.GetId(), plural(len(data.GetPages()), "page"), plural(invalid, "invalid JSON-LD script"))
+
+	for _, p := range data.GetPages() {
+		d := p.GetData()
+		fmt.Println("\n" + p.GetUrl())
+
+		for _, ld := range d.GetJsonLd() {
+			if len(ld.GetError()) > 0 {
+				fmt.Printf("  invalid JSON-LD: %s\n", ld.GetError())
+				continue
+			}
+			fmt.Printf("  JSON-LD: %s\n", typeList(ld.GetTypes()))
+		}
+
+		for _, item := range d.GetMicrodata() {
+			props := fmt.Sprintf("%d properties", len(item.GetProperties()))
+			if len(item.GetProperties()) == 1 {
+				props = "1 property"
+			}
+			fmt.Printf("  Microdata: %s (%s)\n", typeList(item.GetTypes()), props)
+		}
+
+		for _, m := range append(d.GetOpenGraph(), d.GetTwitter()...) {
+			fmt.Printf("  %s: %s\n", m.GetName(), m.GetContent())
+		}
+	}
+}
+
+// typeList returns the types joined by commas, or "untyped" if there aren't
+// any.
+func typeList(types []string) string {
+	if len(types) == 0 {
+		return "untyped"
+	}
+
+	return strings.Join(types, ", ")
+}
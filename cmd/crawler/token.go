@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"net"
+)
+
+// bearerToken implements credentials.PerRPCCredentials by sending the token in
+// the authorization metadata of every request.
+type bearerToken struct {
+	token string
+
+	// insecure is true when the token may be sent without TLS, which is only
+	// allowed for a service on the loopback interface.
+	insecure bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials. The token
+// is only sent over TLS, unless sending it in cleartext was explicitly allowed
+// for a local service.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return !t.insecure
+}
+
+// isLoopback returns true if the host of the address is localhost or a
+// loopback IP address.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
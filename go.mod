@@ -3,6 +3,7 @@ module github.com/wrrn/crawler
 go 1.14
 
 require (
+	github.com/andybalholm/cascadia v1.1.0
 	github.com/golang/protobuf v1.3.4
 	github.com/pkg/errors v0.9.1
 	github.com/xlab/treeprint v1.0.0
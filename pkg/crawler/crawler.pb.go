@@ -1,15 +1,18 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: crawler.proto
 
-package crawler // import "github.com/wrrn/crawler/pkg/crawler"
-
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
+package crawler
 
 import (
-	context "golang.org/x/net/context"
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,30 +24,196 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// NofollowPolicy decides which nofollow directives a crawl respects.
+type NofollowPolicy int32
+
+const (
+	// NOFOLLOW_IGNORE follows every link.
+	NofollowPolicy_NOFOLLOW_IGNORE NofollowPolicy = 0
+	// NOFOLLOW_PAGES doesn't follow the links on pages whose robots meta tag or
+	// X-Robots-Tag header says nofollow.
+	NofollowPolicy_NOFOLLOW_PAGES NofollowPolicy = 1
+	// NOFOLLOW_ALL also doesn't follow links with rel="nofollow".
+	NofollowPolicy_NOFOLLOW_ALL NofollowPolicy = 2
+)
+
+var NofollowPolicy_name = map[int32]string{
+	0: "NOFOLLOW_IGNORE",
+	1: "NOFOLLOW_PAGES",
+	2: "NOFOLLOW_ALL",
+}
+
+var NofollowPolicy_value = map[string]int32{
+	"NOFOLLOW_IGNORE": 0,
+	"NOFOLLOW_PAGES":  1,
+	"NOFOLLOW_ALL":    2,
+}
+
+func (x NofollowPolicy) String() string {
+	return proto.EnumName(NofollowPolicy_name, int32(x))
+}
+
+func (NofollowPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{0}
+}
+
+// ChangeType describes how a page differs between two crawls.
+type ChangeType int32
+
+const (
+	ChangeType_UNCHANGED ChangeType = 0
+	ChangeType_ADDED     ChangeType = 1
+	ChangeType_REMOVED   ChangeType = 2
+	ChangeType_CHANGED   ChangeType = 3
+)
+
+var ChangeType_name = map[int32]string{
+	0: "UNCHANGED",
+	1: "ADDED",
+	2: "REMOVED",
+	3: "CHANGED",
+}
+
+var ChangeType_value = map[string]int32{
+	"UNCHANGED": 0,
+	"ADDED":     1,
+	"REMOVED":   2,
+	"CHANGED":   3,
+}
+
+func (x ChangeType) String() string {
+	return proto.EnumName(ChangeType_name, int32(x))
+}
+
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{1}
+}
+
+// ExportFormat is a format that a crawl can be exported to.
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// SITEMAP is a sitemap.xml of the pages that were successfully fetched. A
+	// sitemap index is used if there are more than 50,000 pages.
+	ExportFormat_SITEMAP ExportFormat = 1
+	// DOT is a Graphviz graph of the site tree.
+	ExportFormat_DOT ExportFormat = 2
+	// GRAPHML is a GraphML graph of the site tree, which can be opened in Gephi.
+	ExportFormat_GRAPHML ExportFormat = 3
+	// MERMAID is a Mermaid flowchart of the site tree.
+	ExportFormat_MERMAID ExportFormat = 4
+	// JSONL is the structured data of the pages as JSON Lines, one page per
+	// line.
+	ExportFormat_JSONL ExportFormat = 5
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "EXPORT_FORMAT_UNSPECIFIED",
+	1: "SITEMAP",
+	2: "DOT",
+	3: "GRAPHML",
+	4: "MERMAID",
+	5: "JSONL",
+}
+
+var ExportFormat_value = map[string]int32{
+	"EXPORT_FORMAT_UNSPECIFIED": 0,
+	"SITEMAP":                   1,
+	"DOT":                       2,
+	"GRAPHML":                   3,
+	"MERMAID":                   4,
+	"JSONL":                     5,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{2}
+}
+
+// AuditIssueType is a kind of SEO problem.
+type AuditIssueType int32
+
+const (
+	AuditIssueType_AUDIT_ISSUE_UNSPECIFIED AuditIssueType = 0
+	AuditIssueType_MISSING_TITLE           AuditIssueType = 1
+	AuditIssueType_DUPLICATE_TITLE         AuditIssueType = 2
+	AuditIssueType_MISSING_DESCRIPTION     AuditIssueType = 3
+	AuditIssueType_DUPLICATE_DESCRIPTION   AuditIssueType = 4
+	AuditIssueType_MISSING_H1              AuditIssueType = 5
+	AuditIssueType_MULTIPLE_H1             AuditIssueType = 6
+	// CANONICAL_ELSEWHERE pages have a canonical link to another URL.
+	AuditIssueType_CANONICAL_ELSEWHERE AuditIssueType = 7
+	AuditIssueType_NOINDEX             AuditIssueType = 8
+	AuditIssueType_LONG_URL            AuditIssueType = 9
+	AuditIssueType_THIN_CONTENT        AuditIssueType = 10
+)
+
+var AuditIssueType_name = map[int32]string{
+	0:  "AUDIT_ISSUE_UNSPECIFIED",
+	1:  "MISSING_TITLE",
+	2:  "DUPLICATE_TITLE",
+	3:  "MISSING_DESCRIPTION",
+	4:  "DUPLICATE_DESCRIPTION",
+	5:  "MISSING_H1",
+	6:  "MULTIPLE_H1",
+	7:  "CANONICAL_ELSEWHERE",
+	8:  "NOINDEX",
+	9:  "LONG_URL",
+	10: "THIN_CONTENT",
+}
+
+var AuditIssueType_value = map[string]int32{
+	"AUDIT_ISSUE_UNSPECIFIED": 0,
+	"MISSING_TITLE":           1,
+	"DUPLICATE_TITLE":         2,
+	"MISSING_DESCRIPTION":     3,
+	"DUPLICATE_DESCRIPTION":   4,
+	"MISSING_H1":              5,
+	"MULTIPLE_H1":             6,
+	"CANONICAL_ELSEWHERE":     7,
+	"NOINDEX":                 8,
+	"LONG_URL":                9,
+	"THIN_CONTENT":            10,
+}
+
+func (x AuditIssueType) String() string {
+	return proto.EnumName(AuditIssueType_name, int32(x))
+}
+
+func (AuditIssueType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{3}
+}
 
 // StartRequest is sent to the service to indicate the URL it should start crawling.
 type StartRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Url                  string        `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Options              *CrawlOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *StartRequest) Reset()         { *m = StartRequest{} }
 func (m *StartRequest) String() string { return proto.CompactTextString(m) }
 func (*StartRequest) ProtoMessage()    {}
 func (*StartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{0}
+	return fileDescriptor_84c7eabcfe7807d1, []int{0}
 }
+
 func (m *StartRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartRequest.Unmarshal(m, b)
 }
 func (m *StartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_StartRequest.Marshal(b, m, deterministic)
 }
-func (dst *StartRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StartRequest.Merge(dst, src)
+func (m *StartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartRequest.Merge(m, src)
 }
 func (m *StartRequest) XXX_Size() int {
 	return xxx_messageInfo_StartRequest.Size(m)
@@ -62,8 +231,420 @@ func (m *StartRequest) GetUrl() string {
 	return ""
 }
 
-// StartResponse indicates a success, but has no fields.
+func (m *StartRequest) GetOptions() *CrawlOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// CrawlOptions configures a single crawl.
+type CrawlOptions struct {
+	// priority is the crawl's share of the service's fetch slots relative to the
+	// other crawls. A crawl with priority 2 is given twice as many fetches as a
+	// crawl with priority 1. Values less than 1 are treated as 1.
+	Priority int32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	// full_recrawl fetches every page again instead of making conditional
+	// requests for the pages found by the previous crawl of the URL.
+	FullRecrawl bool `protobuf:"varint,2,opt,name=full_recrawl,json=fullRecrawl,proto3" json:"full_recrawl,omitempty"`
+	// use_sitemaps seeds the crawl with the pages listed in the site's
+	// sitemaps, found through robots.txt and /sitemap.xml.
+	UseSitemaps bool `protobuf:"varint,3,opt,name=use_sitemaps,json=useSitemaps,proto3" json:"use_sitemaps,omitempty"`
+	// archive writes every request and response made by the crawl to WARC
+	// files in the service's archive directory.
+	Archive bool `protobuf:"varint,4,opt,name=archive,proto3" json:"archive,omitempty"`
+	// replay is the ID of an archived crawl to fetch the pages from instead of
+	// the network. The crawl must have been archived to the service's archive
+	// directory.
+	Replay string `protobuf:"bytes,5,opt,name=replay,proto3" json:"replay,omitempty"`
+	// replay_files are WARC files or directories of them to fetch the pages
+	// from instead of the network, as paths relative to the service's archive
+	// directory. They may have been written by other archivers, and are used
+	// along with the crawl given by replay.
+	ReplayFiles []string `protobuf:"bytes,16,rep,name=replay_files,json=replayFiles,proto3" json:"replay_files,omitempty"`
+	// mirror saves the pages and their assets to the service's mirror
+	// directory, so that the site can be browsed offline.
+	Mirror *MirrorOptions `protobuf:"bytes,6,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	// check_assets requests every asset used by the pages to find their status
+	// and size.
+	CheckAssets bool `protobuf:"varint,7,opt,name=check_assets,json=checkAssets,proto3" json:"check_assets,omitempty"`
+	// check_external_links requests every link to another site once the crawl
+	// has finished, without crawling them any further. The requests are made
+	// one at a time, at most external_links_per_second of them each second,
+	// which defaults to 2.
+	CheckExternalLinks     bool    `protobuf:"varint,8,opt,name=check_external_links,json=checkExternalLinks,proto3" json:"check_external_links,omitempty"`
+	ExternalLinksPerSecond float64 `protobuf:"fixed64,9,opt,name=external_links_per_second,json=externalLinksPerSecond,proto3" json:"external_links_per_second,omitempty"`
+	// nofollow decides which nofollow directives are respected when following
+	// links. By default every link is followed.
+	Nofollow NofollowPolicy `protobuf:"varint,10,opt,name=nofollow,proto3,enum=crawler.v1.NofollowPolicy" json:"nofollow,omitempty"`
+	// canonical_tree builds the site tree from the pages' canonical URLs, so
+	// that duplicates of a page are collapsed onto the page their canonical
+	// link points to.
+	CanonicalTree bool `protobuf:"varint,11,opt,name=canonical_tree,json=canonicalTree,proto3" json:"canonical_tree,omitempty"`
+	// skip_duplicates doesn't follow the links of pages whose text is the same
+	// or nearly the same as a page that was already fetched. Pages are near
+	// duplicates if the SimHashes of their text differ by at most
+	// duplicate_distance bits, which defaults to 3.
+	SkipDuplicates    bool  `protobuf:"varint,12,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"`
+	DuplicateDistance int32 `protobuf:"varint,13,opt,name=duplicate_distance,json=duplicateDistance,proto3" json:"duplicate_distance,omitempty"`
+	// traps configures how URLs that look like crawler traps are detected and
+	// skipped.
+	Traps *TrapOptions `protobuf:"bytes,14,opt,name=traps,proto3" json:"traps,omitempty"`
+	// rules extract named fields from the HTML pages. Conditional requests
+	// aren't made when there are rules, since every page has to be downloaded.
+	Rules                []*ExtractionRule `protobuf:"bytes,15,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CrawlOptions) Reset()         { *m = CrawlOptions{} }
+func (m *CrawlOptions) String() string { return proto.CompactTextString(m) }
+func (*CrawlOptions) ProtoMessage()    {}
+func (*CrawlOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{1}
+}
+
+func (m *CrawlOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrawlOptions.Unmarshal(m, b)
+}
+func (m *CrawlOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrawlOptions.Marshal(b, m, deterministic)
+}
+func (m *CrawlOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrawlOptions.Merge(m, src)
+}
+func (m *CrawlOptions) XXX_Size() int {
+	return xxx_messageInfo_CrawlOptions.Size(m)
+}
+func (m *CrawlOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrawlOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrawlOptions proto.InternalMessageInfo
+
+func (m *CrawlOptions) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CrawlOptions) GetFullRecrawl() bool {
+	if m != nil {
+		return m.FullRecrawl
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetUseSitemaps() bool {
+	if m != nil {
+		return m.UseSitemaps
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetReplay() string {
+	if m != nil {
+		return m.Replay
+	}
+	return ""
+}
+
+func (m *CrawlOptions) GetReplayFiles() []string {
+	if m != nil {
+		return m.ReplayFiles
+	}
+	return nil
+}
+
+func (m *CrawlOptions) GetMirror() *MirrorOptions {
+	if m != nil {
+		return m.Mirror
+	}
+	return nil
+}
+
+func (m *CrawlOptions) GetCheckAssets() bool {
+	if m != nil {
+		return m.CheckAssets
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetCheckExternalLinks() bool {
+	if m != nil {
+		return m.CheckExternalLinks
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetExternalLinksPerSecond() float64 {
+	if m != nil {
+		return m.ExternalLinksPerSecond
+	}
+	return 0
+}
+
+func (m *CrawlOptions) GetNofollow() NofollowPolicy {
+	if m != nil {
+		return m.Nofollow
+	}
+	return NofollowPolicy_NOFOLLOW_IGNORE
+}
+
+func (m *CrawlOptions) GetCanonicalTree() bool {
+	if m != nil {
+		return m.CanonicalTree
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetSkipDuplicates() bool {
+	if m != nil {
+		return m.SkipDuplicates
+	}
+	return false
+}
+
+func (m *CrawlOptions) GetDuplicateDistance() int32 {
+	if m != nil {
+		return m.DuplicateDistance
+	}
+	return 0
+}
+
+func (m *CrawlOptions) GetTraps() *TrapOptions {
+	if m != nil {
+		return m.Traps
+	}
+	return nil
+}
+
+func (m *CrawlOptions) GetRules() []*ExtractionRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// ExtractionRule extracts a named field from the elements of a page that match
+// a CSS selector.
+type ExtractionRule struct {
+	// name is the name of the field, which must be unique among the rules.
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// attribute is the attribute whose value is extracted from each element,
+	// or empty to extract the element's text. href and src attributes are
+	// resolved to absolute URLs.
+	Attribute string `protobuf:"bytes,3,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// url_pattern is a regular expression that the URL of a page must match
+	// for the rule to apply to it. The rule applies to every page if it is
+	// empty.
+	UrlPattern           string   `protobuf:"bytes,4,opt,name=url_pattern,json=urlPattern,proto3" json:"url_pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExtractionRule) Reset()         { *m = ExtractionRule{} }
+func (m *ExtractionRule) String() string { return proto.CompactTextString(m) }
+func (*ExtractionRule) ProtoMessage()    {}
+func (*ExtractionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{2}
+}
+
+func (m *ExtractionRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExtractionRule.Unmarshal(m, b)
+}
+func (m *ExtractionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExtractionRule.Marshal(b, m, deterministic)
+}
+func (m *ExtractionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtractionRule.Merge(m, src)
+}
+func (m *ExtractionRule) XXX_Size() int {
+	return xxx_messageInfo_ExtractionRule.Size(m)
+}
+func (m *ExtractionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtractionRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtractionRule proto.InternalMessageInfo
+
+func (m *ExtractionRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExtractionRule) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *ExtractionRule) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *ExtractionRule) GetUrlPattern() string {
+	if m != nil {
+		return m.UrlPattern
+	}
+	return ""
+}
+
+// TrapOptions configures the heuristics that detect crawler traps. Thresholds
+// that are 0 use the service's defaults.
+type TrapOptions struct {
+	// disabled crawls every URL, even if it looks like a trap.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// max_depth is the most segments a path may have.
+	MaxDepth int32 `protobuf:"varint,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// max_repeats is the most times the same segment may appear in a path.
+	MaxRepeats int32 `protobuf:"varint,3,opt,name=max_repeats,json=maxRepeats,proto3" json:"max_repeats,omitempty"`
+	// max_query_variants is the most query strings that differ in more than
+	// their numbers a path may be seen with.
+	MaxQueryVariants int32 `protobuf:"varint,4,opt,name=max_query_variants,json=maxQueryVariants,proto3" json:"max_query_variants,omitempty"`
+	// max_pages is the longest chain of URLs that may differ only in their
+	// numbers, each found on the page before it, like the months of a calendar.
+	MaxPages             int32    `protobuf:"varint,5,opt,name=max_pages,json=maxPages,proto3" json:"max_pages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrapOptions) Reset()         { *m = TrapOptions{} }
+func (m *TrapOptions) String() string { return proto.CompactTextString(m) }
+func (*TrapOptions) ProtoMessage()    {}
+func (*TrapOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{3}
+}
+
+func (m *TrapOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrapOptions.Unmarshal(m, b)
+}
+func (m *TrapOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrapOptions.Marshal(b, m, deterministic)
+}
+func (m *TrapOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrapOptions.Merge(m, src)
+}
+func (m *TrapOptions) XXX_Size() int {
+	return xxx_messageInfo_TrapOptions.Size(m)
+}
+func (m *TrapOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrapOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrapOptions proto.InternalMessageInfo
+
+func (m *TrapOptions) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *TrapOptions) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *TrapOptions) GetMaxRepeats() int32 {
+	if m != nil {
+		return m.MaxRepeats
+	}
+	return 0
+}
+
+func (m *TrapOptions) GetMaxQueryVariants() int32 {
+	if m != nil {
+		return m.MaxQueryVariants
+	}
+	return 0
+}
+
+func (m *TrapOptions) GetMaxPages() int32 {
+	if m != nil {
+		return m.MaxPages
+	}
+	return 0
+}
+
+// MirrorOptions configures how a crawl is mirrored. The service may limit the
+// sizes further.
+type MirrorOptions struct {
+	// max_total_size is the most bytes that are saved, 0 uses the service's
+	// limit.
+	MaxTotalSize int64 `protobuf:"varint,1,opt,name=max_total_size,json=maxTotalSize,proto3" json:"max_total_size,omitempty"`
+	// max_file_size is the largest file in bytes that is saved, 0 uses the
+	// service's limit.
+	MaxFileSize          int64    `protobuf:"varint,2,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MirrorOptions) Reset()         { *m = MirrorOptions{} }
+func (m *MirrorOptions) String() string { return proto.CompactTextString(m) }
+func (*MirrorOptions) ProtoMessage()    {}
+func (*MirrorOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{4}
+}
+
+func (m *MirrorOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorOptions.Unmarshal(m, b)
+}
+func (m *MirrorOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorOptions.Marshal(b, m, deterministic)
+}
+func (m *MirrorOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorOptions.Merge(m, src)
+}
+func (m *MirrorOptions) XXX_Size() int {
+	return xxx_messageInfo_MirrorOptions.Size(m)
+}
+func (m *MirrorOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorOptions proto.InternalMessageInfo
+
+func (m *MirrorOptions) GetMaxTotalSize() int64 {
+	if m != nil {
+		return m.MaxTotalSize
+	}
+	return 0
+}
+
+func (m *MirrorOptions) GetMaxFileSize() int64 {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return 0
+}
+
+// StartResponse contains the ID of the crawl job, and whether it was queued
+// because the service is already running as many crawls as it allows.
 type StartResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queued               bool     `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,16 +654,17 @@ func (m *StartResponse) Reset()         { *m = StartResponse{} }
 func (m *StartResponse) String() string { return proto.CompactTextString(m) }
 func (*StartResponse) ProtoMessage()    {}
 func (*StartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{1}
+	return fileDescriptor_84c7eabcfe7807d1, []int{5}
 }
+
 func (m *StartResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartResponse.Unmarshal(m, b)
 }
 func (m *StartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_StartResponse.Marshal(b, m, deterministic)
 }
-func (dst *StartResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StartResponse.Merge(dst, src)
+func (m *StartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartResponse.Merge(m, src)
 }
 func (m *StartResponse) XXX_Size() int {
 	return xxx_messageInfo_StartResponse.Size(m)
@@ -93,9 +675,23 @@ func (m *StartResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartResponse proto.InternalMessageInfo
 
+func (m *StartResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StartResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
 // StopRequest is sent to the service to indicate which URL it should stop crawling.
 type StopRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -105,16 +701,17 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{2}
+	return fileDescriptor_84c7eabcfe7807d1, []int{6}
 }
+
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
 }
 func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
 }
-func (dst *StopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopRequest.Merge(dst, src)
+func (m *StopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopRequest.Merge(m, src)
 }
 func (m *StopRequest) XXX_Size() int {
 	return xxx_messageInfo_StopRequest.Size(m)
@@ -143,16 +740,17 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{3}
+	return fileDescriptor_84c7eabcfe7807d1, []int{7}
 }
+
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
 }
 func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
 }
-func (dst *StopResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopResponse.Merge(dst, src)
+func (m *StopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopResponse.Merge(m, src)
 }
 func (m *StopResponse) XXX_Size() int {
 	return xxx_messageInfo_StopResponse.Size(m)
@@ -165,6 +763,8 @@ var xxx_messageInfo_StopResponse proto.InternalMessageInfo
 
 // ListRequest tells the service to return the "site tree" for the all the crawled URLs.
 type ListRequest struct {
+	// include_pages returns the metadata of every page fetched by each crawl.
+	IncludePages         bool     `protobuf:"varint,1,opt,name=include_pages,json=includePages,proto3" json:"include_pages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -174,16 +774,17 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{4}
+	return fileDescriptor_84c7eabcfe7807d1, []int{8}
 }
+
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
 }
 func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRequest.Merge(dst, src)
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
 }
 func (m *ListRequest) XXX_Size() int {
 	return xxx_messageInfo_ListRequest.Size(m)
@@ -194,9 +795,16 @@ func (m *ListRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListRequest proto.InternalMessageInfo
 
+func (m *ListRequest) GetIncludePages() bool {
+	if m != nil {
+		return m.IncludePages
+	}
+	return false
+}
+
 // ListResponse contains the "site trees" for all of the crawled URLs.
 type ListResponse struct {
-	SiteTrees            []*SiteTree `protobuf:"bytes,1,rep,name=site_trees,json=siteTrees" json:"site_trees,omitempty"`
+	SiteTrees            []*SiteTree `protobuf:"bytes,1,rep,name=site_trees,json=siteTrees,proto3" json:"site_trees,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -206,16 +814,17 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{5}
+	return fileDescriptor_84c7eabcfe7807d1, []int{9}
 }
+
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
 }
 func (m *ListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListResponse.Merge(dst, src)
+func (m *ListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResponse.Merge(m, src)
 }
 func (m *ListResponse) XXX_Size() int {
 	return xxx_messageInfo_ListResponse.Size(m)
@@ -233,31 +842,120 @@ func (m *ListResponse) GetSiteTrees() []*SiteTree {
 	return nil
 }
 
-// SiteTree represents a single url's site tree.
-type SiteTree struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	Tree                 *Tree    `protobuf:"bytes,2,opt,name=tree" json:"tree,omitempty"`
+// DeleteRequest is sent to the service to indicate which URL's site trees it should remove.
+type DeleteRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SiteTree) Reset()         { *m = SiteTree{} }
-func (m *SiteTree) String() string { return proto.CompactTextString(m) }
-func (*SiteTree) ProtoMessage()    {}
-func (*SiteTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{6}
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{10}
 }
-func (m *SiteTree) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SiteTree.Unmarshal(m, b)
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
 }
-func (m *SiteTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SiteTree.Marshal(b, m, deterministic)
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
 }
-func (dst *SiteTree) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SiteTree.Merge(dst, src)
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
 }
-func (m *SiteTree) XXX_Size() int {
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// DeleteResponse contains the number of crawl results that were removed.
+type DeleteResponse struct {
+	Deleted              int32    `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{11}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+func (m *DeleteResponse) GetDeleted() int32 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+// SiteTree represents a single url's site tree.
+type SiteTree struct {
+	Url  string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Tree *Tree  `protobuf:"bytes,2,opt,name=tree,proto3" json:"tree,omitempty"`
+	// id is the ID of the crawl that produced the tree.
+	Id    string      `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Stats *CrawlStats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	// pages are the pages fetched by the crawl sorted by URL, and skipped are
+	// the URLs that were skipped as crawler traps in the order they were found.
+	// They are only returned if the request asked for the pages.
+	Pages                []*Page       `protobuf:"bytes,5,rep,name=pages,proto3" json:"pages,omitempty"`
+	Skipped              []*SkippedURL `protobuf:"bytes,6,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SiteTree) Reset()         { *m = SiteTree{} }
+func (m *SiteTree) String() string { return proto.CompactTextString(m) }
+func (*SiteTree) ProtoMessage()    {}
+func (*SiteTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{12}
+}
+
+func (m *SiteTree) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SiteTree.Unmarshal(m, b)
+}
+func (m *SiteTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SiteTree.Marshal(b, m, deterministic)
+}
+func (m *SiteTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SiteTree.Merge(m, src)
+}
+func (m *SiteTree) XXX_Size() int {
 	return xxx_messageInfo_SiteTree.Size(m)
 }
 func (m *SiteTree) XXX_DiscardUnknown() {
@@ -280,10 +978,158 @@ func (m *SiteTree) GetTree() *Tree {
 	return nil
 }
 
+func (m *SiteTree) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SiteTree) GetStats() *CrawlStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *SiteTree) GetPages() []*Page {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+func (m *SiteTree) GetSkipped() []*SkippedURL {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
+// SkippedURL is a URL that a crawl found but didn't fetch because it looked
+// like a crawler trap. reason is the heuristic that detected the trap, one of
+// "repeating-segments", "depth", "query-variants" and "pagination".
+type SkippedURL struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SkippedURL) Reset()         { *m = SkippedURL{} }
+func (m *SkippedURL) String() string { return proto.CompactTextString(m) }
+func (*SkippedURL) ProtoMessage()    {}
+func (*SkippedURL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{13}
+}
+
+func (m *SkippedURL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SkippedURL.Unmarshal(m, b)
+}
+func (m *SkippedURL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SkippedURL.Marshal(b, m, deterministic)
+}
+func (m *SkippedURL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedURL.Merge(m, src)
+}
+func (m *SkippedURL) XXX_Size() int {
+	return xxx_messageInfo_SkippedURL.Size(m)
+}
+func (m *SkippedURL) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedURL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedURL proto.InternalMessageInfo
+
+func (m *SkippedURL) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SkippedURL) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// CrawlStats summarizes the pages fetched by a crawl.
+type CrawlStats struct {
+	Pages int32 `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	// unchanged is the number of pages that the server reported had not been
+	// modified since the previous crawl.
+	Unchanged int32 `protobuf:"varint,2,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	// failed is the number of pages that could not be fetched.
+	Failed int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// skipped is the number of URLs that were skipped as crawler traps.
+	Skipped              int32    `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CrawlStats) Reset()         { *m = CrawlStats{} }
+func (m *CrawlStats) String() string { return proto.CompactTextString(m) }
+func (*CrawlStats) ProtoMessage()    {}
+func (*CrawlStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{14}
+}
+
+func (m *CrawlStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrawlStats.Unmarshal(m, b)
+}
+func (m *CrawlStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrawlStats.Marshal(b, m, deterministic)
+}
+func (m *CrawlStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrawlStats.Merge(m, src)
+}
+func (m *CrawlStats) XXX_Size() int {
+	return xxx_messageInfo_CrawlStats.Size(m)
+}
+func (m *CrawlStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrawlStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrawlStats proto.InternalMessageInfo
+
+func (m *CrawlStats) GetPages() int32 {
+	if m != nil {
+		return m.Pages
+	}
+	return 0
+}
+
+func (m *CrawlStats) GetUnchanged() int32 {
+	if m != nil {
+		return m.Unchanged
+	}
+	return 0
+}
+
+func (m *CrawlStats) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *CrawlStats) GetSkipped() int32 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
 // Tree represents a site's directory tree. A tree that does not have children is considered a leaf node.
 type Tree struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Children             []*Tree  `protobuf:"bytes,2,rep,name=children" json:"children,omitempty"`
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Children []*Tree `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	// noindex is true if the page at the tree's path asks not to be indexed.
+	Noindex              bool     `protobuf:"varint,3,opt,name=noindex,proto3" json:"noindex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -293,16 +1139,17 @@ func (m *Tree) Reset()         { *m = Tree{} }
 func (m *Tree) String() string { return proto.CompactTextString(m) }
 func (*Tree) ProtoMessage()    {}
 func (*Tree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_crawler_297b505cae0bb36a, []int{7}
+	return fileDescriptor_84c7eabcfe7807d1, []int{15}
 }
+
 func (m *Tree) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tree.Unmarshal(m, b)
 }
 func (m *Tree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Tree.Marshal(b, m, deterministic)
 }
-func (dst *Tree) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Tree.Merge(dst, src)
+func (m *Tree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tree.Merge(m, src)
 }
 func (m *Tree) XXX_Size() int {
 	return xxx_messageInfo_Tree.Size(m)
@@ -327,80 +1174,4299 @@ func (m *Tree) GetChildren() []*Tree {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*StartRequest)(nil), "crawler.v1.StartRequest")
-	proto.RegisterType((*StartResponse)(nil), "crawler.v1.StartResponse")
-	proto.RegisterType((*StopRequest)(nil), "crawler.v1.StopRequest")
-	proto.RegisterType((*StopResponse)(nil), "crawler.v1.StopResponse")
-	proto.RegisterType((*ListRequest)(nil), "crawler.v1.ListRequest")
-	proto.RegisterType((*ListResponse)(nil), "crawler.v1.ListResponse")
-	proto.RegisterType((*SiteTree)(nil), "crawler.v1.SiteTree")
-	proto.RegisterType((*Tree)(nil), "crawler.v1.Tree")
+func (m *Tree) GetNoindex() bool {
+	if m != nil {
+		return m.Noindex
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// CreateScheduleRequest describes when and how a URL should be crawled. Exactly
+// one of cron or interval must be set.
+type CreateScheduleRequest struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// cron is a five field cron expression, e.g. "0 3 * * mon".
+	Cron string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	// interval runs the crawl repeatedly with the given time between runs.
+	Interval             *duration.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Options              *CrawlOptions      `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CreateScheduleRequest) Reset()         { *m = CreateScheduleRequest{} }
+func (m *CreateScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduleRequest) ProtoMessage()    {}
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{16}
+}
+
+func (m *CreateScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScheduleRequest.Unmarshal(m, b)
+}
+func (m *CreateScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleRequest.Merge(m, src)
+}
+func (m *CreateScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateScheduleRequest.Size(m)
+}
+func (m *CreateScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleRequest.DiscardUnknown(m)
+}
 
-// Client API for Crawler service
+var xxx_messageInfo_CreateScheduleRequest proto.InternalMessageInfo
 
-type CrawlerClient interface {
-	// Start signals the service to start crawling the given URL.
-	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
-	// Stop signals the service to stop crawling the given URL.
-	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
-	// Show the current site tree for all the given URLs.
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+func (m *CreateScheduleRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-type crawlerClient struct {
-	cc *grpc.ClientConn
+func (m *CreateScheduleRequest) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *CreateScheduleRequest) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *CreateScheduleRequest) GetOptions() *CrawlOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// CreateScheduleResponse contains the schedule that was created.
+type CreateScheduleResponse struct {
+	Schedule             *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateScheduleResponse) Reset()         { *m = CreateScheduleResponse{} }
+func (m *CreateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateScheduleResponse) ProtoMessage()    {}
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{17}
+}
+
+func (m *CreateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScheduleResponse.Unmarshal(m, b)
+}
+func (m *CreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScheduleResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleResponse.Merge(m, src)
+}
+func (m *CreateScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateScheduleResponse.Size(m)
+}
+func (m *CreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScheduleResponse proto.InternalMessageInfo
+
+func (m *CreateScheduleResponse) GetSchedule() *Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// ListSchedulesRequest tells the service to return all of the schedules.
+type ListSchedulesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSchedulesRequest) Reset()         { *m = ListSchedulesRequest{} }
+func (m *ListSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulesRequest) ProtoMessage()    {}
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{18}
+}
+
+func (m *ListSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSchedulesRequest.Unmarshal(m, b)
+}
+func (m *ListSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSchedulesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesRequest.Merge(m, src)
+}
+func (m *ListSchedulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSchedulesRequest.Size(m)
+}
+func (m *ListSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulesRequest proto.InternalMessageInfo
+
+// ListSchedulesResponse contains all of the schedules.
+type ListSchedulesResponse struct {
+	Schedules            []*Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListSchedulesResponse) Reset()         { *m = ListSchedulesResponse{} }
+func (m *ListSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSchedulesResponse) ProtoMessage()    {}
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{19}
+}
+
+func (m *ListSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSchedulesResponse.Unmarshal(m, b)
+}
+func (m *ListSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSchedulesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesResponse.Merge(m, src)
+}
+func (m *ListSchedulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSchedulesResponse.Size(m)
+}
+func (m *ListSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulesResponse proto.InternalMessageInfo
+
+func (m *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+// DeleteScheduleRequest is sent to the service to indicate which schedule it should remove.
+type DeleteScheduleRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScheduleRequest) Reset()         { *m = DeleteScheduleRequest{} }
+func (m *DeleteScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteScheduleRequest) ProtoMessage()    {}
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{20}
+}
+
+func (m *DeleteScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScheduleRequest.Unmarshal(m, b)
+}
+func (m *DeleteScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduleRequest.Merge(m, src)
+}
+func (m *DeleteScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteScheduleRequest.Size(m)
+}
+func (m *DeleteScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScheduleRequest proto.InternalMessageInfo
+
+func (m *DeleteScheduleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// DeleteScheduleResponse indicates a success, but has no fields.
+type DeleteScheduleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScheduleResponse) Reset()         { *m = DeleteScheduleResponse{} }
+func (m *DeleteScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteScheduleResponse) ProtoMessage()    {}
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{21}
+}
+
+func (m *DeleteScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScheduleResponse.Unmarshal(m, b)
+}
+func (m *DeleteScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScheduleResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduleResponse.Merge(m, src)
+}
+func (m *DeleteScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteScheduleResponse.Size(m)
+}
+func (m *DeleteScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScheduleResponse proto.InternalMessageInfo
+
+// Schedule is a URL that is crawled at regular times.
+type Schedule struct {
+	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url      string             `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Cron     string             `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	Interval *duration.Duration `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Options  *CrawlOptions      `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	// owner is the user that created the schedule.
+	Owner   string               `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	NextRun *timestamp.Timestamp `protobuf:"bytes,7,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	// runs are the most recent runs of the schedule, oldest first.
+	Runs                 []*ScheduledRun `protobuf:"bytes,8,rep,name=runs,proto3" json:"runs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{22}
+}
+
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Schedule.Unmarshal(m, b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return xxx_messageInfo_Schedule.Size(m)
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Schedule) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Schedule) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *Schedule) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *Schedule) GetOptions() *CrawlOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Schedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Schedule) GetNextRun() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRun
+	}
+	return nil
+}
+
+func (m *Schedule) GetRuns() []*ScheduledRun {
+	if m != nil {
+		return m.Runs
+	}
+	return nil
+}
+
+// ScheduledRun is a single time that a schedule was due to run.
+type ScheduledRun struct {
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// job_id is the ID of the crawl job that was started. It is empty if the run
+	// was skipped or failed.
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// skipped is true if the run was skipped because the previous crawl of the
+	// URL was still going.
+	Skipped bool `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// error describes why the crawl could not be started.
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduledRun) Reset()         { *m = ScheduledRun{} }
+func (m *ScheduledRun) String() string { return proto.CompactTextString(m) }
+func (*ScheduledRun) ProtoMessage()    {}
+func (*ScheduledRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{23}
+}
+
+func (m *ScheduledRun) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduledRun.Unmarshal(m, b)
+}
+func (m *ScheduledRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduledRun.Marshal(b, m, deterministic)
+}
+func (m *ScheduledRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledRun.Merge(m, src)
+}
+func (m *ScheduledRun) XXX_Size() int {
+	return xxx_messageInfo_ScheduledRun.Size(m)
+}
+func (m *ScheduledRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledRun proto.InternalMessageInfo
+
+func (m *ScheduledRun) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *ScheduledRun) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *ScheduledRun) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *ScheduledRun) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// DiffRequest is sent to the service to compare two crawls of a URL. If the
+// IDs are empty then the two most recent crawls are compared.
+type DiffRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	FromId               string   `protobuf:"bytes,2,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId                 string   `protobuf:"bytes,3,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
+func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffRequest) ProtoMessage()    {}
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{24}
+}
+
+func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffRequest.Unmarshal(m, b)
+}
+func (m *DiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffRequest.Marshal(b, m, deterministic)
+}
+func (m *DiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffRequest.Merge(m, src)
+}
+func (m *DiffRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffRequest.Size(m)
+}
+func (m *DiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffRequest proto.InternalMessageInfo
+
+func (m *DiffRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetFromId() string {
+	if m != nil {
+		return m.FromId
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetToId() string {
+	if m != nil {
+		return m.ToId
+	}
+	return ""
+}
+
+// DiffResponse contains the differences between two crawls.
+type DiffResponse struct {
+	FromId string `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// tree is the union of both crawls' site trees, where each node is marked
+	// with how it changed.
+	Tree *DiffTree `protobuf:"bytes,3,opt,name=tree,proto3" json:"tree,omitempty"`
+	// changes are the pages that were added, removed or changed, sorted by path.
+	Changes              []*PageChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
+func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffResponse) ProtoMessage()    {}
+func (*DiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{25}
+}
+
+func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffResponse.Unmarshal(m, b)
+}
+func (m *DiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffResponse.Marshal(b, m, deterministic)
+}
+func (m *DiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffResponse.Merge(m, src)
+}
+func (m *DiffResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffResponse.Size(m)
+}
+func (m *DiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffResponse proto.InternalMessageInfo
+
+func (m *DiffResponse) GetFromId() string {
+	if m != nil {
+		return m.FromId
+	}
+	return ""
+}
+
+func (m *DiffResponse) GetToId() string {
+	if m != nil {
+		return m.ToId
+	}
+	return ""
+}
+
+func (m *DiffResponse) GetTree() *DiffTree {
+	if m != nil {
+		return m.Tree
+	}
+	return nil
+}
+
+func (m *DiffResponse) GetChanges() []*PageChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// DiffTree is a site tree where each node is marked with how it changed.
+type DiffTree struct {
+	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Change               ChangeType  `protobuf:"varint,2,opt,name=change,proto3,enum=crawler.v1.ChangeType" json:"change,omitempty"`
+	Children             []*DiffTree `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DiffTree) Reset()         { *m = DiffTree{} }
+func (m *DiffTree) String() string { return proto.CompactTextString(m) }
+func (*DiffTree) ProtoMessage()    {}
+func (*DiffTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{26}
+}
+
+func (m *DiffTree) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffTree.Unmarshal(m, b)
+}
+func (m *DiffTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffTree.Marshal(b, m, deterministic)
+}
+func (m *DiffTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffTree.Merge(m, src)
+}
+func (m *DiffTree) XXX_Size() int {
+	return xxx_messageInfo_DiffTree.Size(m)
+}
+func (m *DiffTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffTree.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffTree proto.InternalMessageInfo
+
+func (m *DiffTree) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DiffTree) GetChange() ChangeType {
+	if m != nil {
+		return m.Change
+	}
+	return ChangeType_UNCHANGED
+}
+
+func (m *DiffTree) GetChildren() []*DiffTree {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+// PageChange describes how a page differs between two crawls.
+type PageChange struct {
+	Path   string     `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Change ChangeType `protobuf:"varint,2,opt,name=change,proto3,enum=crawler.v1.ChangeType" json:"change,omitempty"`
+	// from is empty for added pages, and to is empty for removed pages.
+	From *Page `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   *Page `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// fields are the fields that changed, any of "status", "title" and "content".
+	Fields               []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageChange) Reset()         { *m = PageChange{} }
+func (m *PageChange) String() string { return proto.CompactTextString(m) }
+func (*PageChange) ProtoMessage()    {}
+func (*PageChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{27}
+}
+
+func (m *PageChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageChange.Unmarshal(m, b)
+}
+func (m *PageChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageChange.Marshal(b, m, deterministic)
+}
+func (m *PageChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageChange.Merge(m, src)
+}
+func (m *PageChange) XXX_Size() int {
+	return xxx_messageInfo_PageChange.Size(m)
+}
+func (m *PageChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageChange proto.InternalMessageInfo
+
+func (m *PageChange) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PageChange) GetChange() ChangeType {
+	if m != nil {
+		return m.Change
+	}
+	return ChangeType_UNCHANGED
+}
+
+func (m *PageChange) GetFrom() *Page {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *PageChange) GetTo() *Page {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *PageChange) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// Page is the metadata of a single page fetched during a crawl.
+type Page struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// status is the HTTP status code of the response. It is 0 if the request
+	// failed, in which case error describes why.
+	Status      int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error       string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Title       string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// content_hash is the hex encoded SHA-256 of the response body.
+	ContentHash string `protobuf:"bytes,6,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// links are the URLs of the pages on the same site that the page links to.
+	Links     []string             `protobuf:"bytes,7,rep,name=links,proto3" json:"links,omitempty"`
+	FetchedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
+	// etag and last_modified are the validators sent by the server.
+	Etag         string `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"`
+	LastModified string `protobuf:"bytes,10,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	// not_modified is true if the server reported that the page had not been
+	// modified since the previous crawl.
+	NotModified bool `protobuf:"varint,11,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	// size is the length of the response body in bytes.
+	Size int64 `protobuf:"varint,12,opt,name=size,proto3" json:"size,omitempty"`
+	// external_links are the URLs on other sites that the page links to.
+	ExternalLinks []string `protobuf:"bytes,13,rep,name=external_links,json=externalLinks,proto3" json:"external_links,omitempty"`
+	// description is the page's meta description, and h1s are the text of its
+	// top level headings.
+	Description string   `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	H1S         []string `protobuf:"bytes,15,rep,name=h1s,proto3" json:"h1s,omitempty"`
+	// canonical is the URL of the page's canonical link, if it has one.
+	Canonical string `protobuf:"bytes,16,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	// noindex is true if the page asks not to be indexed.
+	Noindex bool `protobuf:"varint,17,opt,name=noindex,proto3" json:"noindex,omitempty"`
+	// words is the number of words in the page's visible text.
+	Words int32 `protobuf:"varint,18,opt,name=words,proto3" json:"words,omitempty"`
+	// nofollow is true if the page asks for its links not to be followed, and
+	// nofollow_links are the links that the page only links to with
+	// rel="nofollow".
+	Nofollow      bool     `protobuf:"varint,19,opt,name=nofollow,proto3" json:"nofollow,omitempty"`
+	NofollowLinks []string `protobuf:"bytes,20,rep,name=nofollow_links,json=nofollowLinks,proto3" json:"nofollow_links,omitempty"`
+	// hreflangs are the page's links to versions of itself in other languages.
+	Hreflangs []*Hreflang `protobuf:"bytes,21,rep,name=hreflangs,proto3" json:"hreflangs,omitempty"`
+	// text_hash is the hex encoded SHA-256 of the page's visible text, and
+	// simhash is a fingerprint of the text that differs by only a few bits for
+	// pages with nearly the same text.
+	TextHash string `protobuf:"bytes,22,opt,name=text_hash,json=textHash,proto3" json:"text_hash,omitempty"`
+	Simhash  uint64 `protobuf:"fixed64,23,opt,name=simhash,proto3" json:"simhash,omitempty"`
+	// duplicate_of is the URL of an earlier page with the same or nearly the
+	// same text, if the crawl skipped duplicates.
+	DuplicateOf string `protobuf:"bytes,24,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	// structured_data is the page's embedded metadata, if it has any.
+	StructuredData       *StructuredData `protobuf:"bytes,25,opt,name=structured_data,json=structuredData,proto3" json:"structured_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Page) Reset()         { *m = Page{} }
+func (m *Page) String() string { return proto.CompactTextString(m) }
+func (*Page) ProtoMessage()    {}
+func (*Page) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{28}
+}
+
+func (m *Page) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Page.Unmarshal(m, b)
+}
+func (m *Page) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Page.Marshal(b, m, deterministic)
+}
+func (m *Page) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Page.Merge(m, src)
+}
+func (m *Page) XXX_Size() int {
+	return xxx_messageInfo_Page.Size(m)
+}
+func (m *Page) XXX_DiscardUnknown() {
+	xxx_messageInfo_Page.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Page proto.InternalMessageInfo
+
+func (m *Page) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Page) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Page) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Page) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Page) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Page) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *Page) GetLinks() []string {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *Page) GetFetchedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.FetchedAt
+	}
+	return nil
+}
+
+func (m *Page) GetEtag() string {
+	if m != nil {
+		return m.Etag
+	}
+	return ""
+}
+
+func (m *Page) GetLastModified() string {
+	if m != nil {
+		return m.LastModified
+	}
+	return ""
+}
+
+func (m *Page) GetNotModified() bool {
+	if m != nil {
+		return m.NotModified
+	}
+	return false
+}
+
+func (m *Page) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Page) GetExternalLinks() []string {
+	if m != nil {
+		return m.ExternalLinks
+	}
+	return nil
+}
+
+func (m *Page) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Page) GetH1S() []string {
+	if m != nil {
+		return m.H1S
+	}
+	return nil
+}
+
+func (m *Page) GetCanonical() string {
+	if m != nil {
+		return m.Canonical
+	}
+	return ""
+}
+
+func (m *Page) GetNoindex() bool {
+	if m != nil {
+		return m.Noindex
+	}
+	return false
+}
+
+func (m *Page) GetWords() int32 {
+	if m != nil {
+		return m.Words
+	}
+	return 0
+}
+
+func (m *Page) GetNofollow() bool {
+	if m != nil {
+		return m.Nofollow
+	}
+	return false
+}
+
+func (m *Page) GetNofollowLinks() []string {
+	if m != nil {
+		return m.NofollowLinks
+	}
+	return nil
+}
+
+func (m *Page) GetHreflangs() []*Hreflang {
+	if m != nil {
+		return m.Hreflangs
+	}
+	return nil
+}
+
+func (m *Page) GetTextHash() string {
+	if m != nil {
+		return m.TextHash
+	}
+	return ""
+}
+
+func (m *Page) GetSimhash() uint64 {
+	if m != nil {
+		return m.Simhash
+	}
+	return 0
+}
+
+func (m *Page) GetDuplicateOf() string {
+	if m != nil {
+		return m.DuplicateOf
+	}
+	return ""
+}
+
+func (m *Page) GetStructuredData() *StructuredData {
+	if m != nil {
+		return m.StructuredData
+	}
+	return nil
+}
+
+// StructuredData is the machine readable metadata embedded in a page.
+type StructuredData struct {
+	JsonLd    []*JsonLd        `protobuf:"bytes,1,rep,name=json_ld,json=jsonLd,proto3" json:"json_ld,omitempty"`
+	Microdata []*MicrodataItem `protobuf:"bytes,2,rep,name=microdata,proto3" json:"microdata,omitempty"`
+	// open_graph are the page's og: meta tags, and twitter are its twitter:
+	// meta tags, in the order they appear.
+	OpenGraph            []*MetaProperty `protobuf:"bytes,3,rep,name=open_graph,json=openGraph,proto3" json:"open_graph,omitempty"`
+	Twitter              []*MetaProperty `protobuf:"bytes,4,rep,name=twitter,proto3" json:"twitter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StructuredData) Reset()         { *m = StructuredData{} }
+func (m *StructuredData) String() string { return proto.CompactTextString(m) }
+func (*StructuredData) ProtoMessage()    {}
+func (*StructuredData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{29}
+}
+
+func (m *StructuredData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StructuredData.Unmarshal(m, b)
+}
+func (m *StructuredData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StructuredData.Marshal(b, m, deterministic)
+}
+func (m *StructuredData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredData.Merge(m, src)
+}
+func (m *StructuredData) XXX_Size() int {
+	return xxx_messageInfo_StructuredData.Size(m)
+}
+func (m *StructuredData) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructuredData proto.InternalMessageInfo
+
+func (m *StructuredData) GetJsonLd() []*JsonLd {
+	if m != nil {
+		return m.JsonLd
+	}
+	return nil
+}
+
+func (m *StructuredData) GetMicrodata() []*MicrodataItem {
+	if m != nil {
+		return m.Microdata
+	}
+	return nil
+}
+
+func (m *StructuredData) GetOpenGraph() []*MetaProperty {
+	if m != nil {
+		return m.OpenGraph
+	}
+	return nil
+}
+
+func (m *StructuredData) GetTwitter() []*MetaProperty {
+	if m != nil {
+		return m.Twitter
+	}
+	return nil
+}
+
+// JsonLd is a JSON-LD script. error describes why the script isn't valid JSON,
+// and is empty if it is. types are the @type values of the script's top level
+// objects, including those in an @graph.
+type JsonLd struct {
+	Raw                  string   `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Types                []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JsonLd) Reset()         { *m = JsonLd{} }
+func (m *JsonLd) String() string { return proto.CompactTextString(m) }
+func (*JsonLd) ProtoMessage()    {}
+func (*JsonLd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{30}
+}
+
+func (m *JsonLd) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JsonLd.Unmarshal(m, b)
+}
+func (m *JsonLd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JsonLd.Marshal(b, m, deterministic)
+}
+func (m *JsonLd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsonLd.Merge(m, src)
+}
+func (m *JsonLd) XXX_Size() int {
+	return xxx_messageInfo_JsonLd.Size(m)
+}
+func (m *JsonLd) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsonLd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JsonLd proto.InternalMessageInfo
+
+func (m *JsonLd) GetRaw() string {
+	if m != nil {
+		return m.Raw
+	}
+	return ""
+}
+
+func (m *JsonLd) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *JsonLd) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+// MicrodataItem is a Microdata item, an element with an itemscope attribute.
+type MicrodataItem struct {
+	Types                []string             `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	Id                   string               `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Properties           []*MicrodataProperty `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MicrodataItem) Reset()         { *m = MicrodataItem{} }
+func (m *MicrodataItem) String() string { return proto.CompactTextString(m) }
+func (*MicrodataItem) ProtoMessage()    {}
+func (*MicrodataItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{31}
+}
+
+func (m *MicrodataItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MicrodataItem.Unmarshal(m, b)
+}
+func (m *MicrodataItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MicrodataItem.Marshal(b, m, deterministic)
+}
+func (m *MicrodataItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MicrodataItem.Merge(m, src)
+}
+func (m *MicrodataItem) XXX_Size() int {
+	return xxx_messageInfo_MicrodataItem.Size(m)
+}
+func (m *MicrodataItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MicrodataItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MicrodataItem proto.InternalMessageInfo
+
+func (m *MicrodataItem) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *MicrodataItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MicrodataItem) GetProperties() []*MicrodataProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// MicrodataProperty is a property of a Microdata item. item is set if the
+// property's value is another item, otherwise value is its text value.
+type MicrodataProperty struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string         `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Item                 *MicrodataItem `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MicrodataProperty) Reset()         { *m = MicrodataProperty{} }
+func (m *MicrodataProperty) String() string { return proto.CompactTextString(m) }
+func (*MicrodataProperty) ProtoMessage()    {}
+func (*MicrodataProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{32}
+}
+
+func (m *MicrodataProperty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MicrodataProperty.Unmarshal(m, b)
+}
+func (m *MicrodataProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MicrodataProperty.Marshal(b, m, deterministic)
+}
+func (m *MicrodataProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MicrodataProperty.Merge(m, src)
+}
+func (m *MicrodataProperty) XXX_Size() int {
+	return xxx_messageInfo_MicrodataProperty.Size(m)
+}
+func (m *MicrodataProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MicrodataProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MicrodataProperty proto.InternalMessageInfo
+
+func (m *MicrodataProperty) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MicrodataProperty) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MicrodataProperty) GetItem() *MicrodataItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+// MetaProperty is a meta tag's property and content.
+type MetaProperty struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content              string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetaProperty) Reset()         { *m = MetaProperty{} }
+func (m *MetaProperty) String() string { return proto.CompactTextString(m) }
+func (*MetaProperty) ProtoMessage()    {}
+func (*MetaProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{33}
+}
+
+func (m *MetaProperty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetaProperty.Unmarshal(m, b)
+}
+func (m *MetaProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetaProperty.Marshal(b, m, deterministic)
+}
+func (m *MetaProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaProperty.Merge(m, src)
+}
+func (m *MetaProperty) XXX_Size() int {
+	return xxx_messageInfo_MetaProperty.Size(m)
+}
+func (m *MetaProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaProperty proto.InternalMessageInfo
+
+func (m *MetaProperty) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MetaProperty) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+// Hreflang is a link from a page to a version of itself in the language given
+// by lang, like "en-gb" or "x-default".
+type Hreflang struct {
+	Lang                 string   `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hreflang) Reset()         { *m = Hreflang{} }
+func (m *Hreflang) String() string { return proto.CompactTextString(m) }
+func (*Hreflang) ProtoMessage()    {}
+func (*Hreflang) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{34}
+}
+
+func (m *Hreflang) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hreflang.Unmarshal(m, b)
+}
+func (m *Hreflang) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hreflang.Marshal(b, m, deterministic)
+}
+func (m *Hreflang) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hreflang.Merge(m, src)
+}
+func (m *Hreflang) XXX_Size() int {
+	return xxx_messageInfo_Hreflang.Size(m)
+}
+func (m *Hreflang) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hreflang.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hreflang proto.InternalMessageInfo
+
+func (m *Hreflang) GetLang() string {
+	if m != nil {
+		return m.Lang
+	}
+	return ""
+}
+
+func (m *Hreflang) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// SitemapReportRequest is sent to the service to compare a crawl's sitemap and
+// links. If the ID is empty then the most recent crawl of the URL is used.
+type SitemapReportRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SitemapReportRequest) Reset()         { *m = SitemapReportRequest{} }
+func (m *SitemapReportRequest) String() string { return proto.CompactTextString(m) }
+func (*SitemapReportRequest) ProtoMessage()    {}
+func (*SitemapReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{35}
+}
+
+func (m *SitemapReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SitemapReportRequest.Unmarshal(m, b)
+}
+func (m *SitemapReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SitemapReportRequest.Marshal(b, m, deterministic)
+}
+func (m *SitemapReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SitemapReportRequest.Merge(m, src)
+}
+func (m *SitemapReportRequest) XXX_Size() int {
+	return xxx_messageInfo_SitemapReportRequest.Size(m)
+}
+func (m *SitemapReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SitemapReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SitemapReportRequest proto.InternalMessageInfo
+
+func (m *SitemapReportRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SitemapReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// SitemapReportResponse lists the pages that are only found one way.
+type SitemapReportResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sitemap_urls is the number of URLs on the site listed in its sitemaps.
+	SitemapUrls int32 `protobuf:"varint,2,opt,name=sitemap_urls,json=sitemapUrls,proto3" json:"sitemap_urls,omitempty"`
+	// sitemap_only are the URLs in the sitemaps that no crawled page links to.
+	SitemapOnly []string `protobuf:"bytes,3,rep,name=sitemap_only,json=sitemapOnly,proto3" json:"sitemap_only,omitempty"`
+	// links_only are the URLs of the crawled pages that are linked to but are
+	// not in the sitemaps.
+	LinksOnly            []string `protobuf:"bytes,4,rep,name=links_only,json=linksOnly,proto3" json:"links_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SitemapReportResponse) Reset()         { *m = SitemapReportResponse{} }
+func (m *SitemapReportResponse) String() string { return proto.CompactTextString(m) }
+func (*SitemapReportResponse) ProtoMessage()    {}
+func (*SitemapReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{36}
+}
+
+func (m *SitemapReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SitemapReportResponse.Unmarshal(m, b)
+}
+func (m *SitemapReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SitemapReportResponse.Marshal(b, m, deterministic)
+}
+func (m *SitemapReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SitemapReportResponse.Merge(m, src)
+}
+func (m *SitemapReportResponse) XXX_Size() int {
+	return xxx_messageInfo_SitemapReportResponse.Size(m)
+}
+func (m *SitemapReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SitemapReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SitemapReportResponse proto.InternalMessageInfo
+
+func (m *SitemapReportResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SitemapReportResponse) GetSitemapUrls() int32 {
+	if m != nil {
+		return m.SitemapUrls
+	}
+	return 0
+}
+
+func (m *SitemapReportResponse) GetSitemapOnly() []string {
+	if m != nil {
+		return m.SitemapOnly
+	}
+	return nil
+}
+
+func (m *SitemapReportResponse) GetLinksOnly() []string {
+	if m != nil {
+		return m.LinksOnly
+	}
+	return nil
+}
+
+// ExportRequest is sent to the service to export a crawl. If the ID is empty
+// then the most recent crawl of the URL is exported.
+type ExportRequest struct {
+	Url    string       `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id     string       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Format ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=crawler.v1.ExportFormat" json:"format,omitempty"`
+	// base_url is the URL the exported files will be served from. It is used by
+	// formats that reference their other files, like sitemap indexes. Defaults to
+	// the root of the crawled site.
+	BaseUrl string `protobuf:"bytes,4,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	// graph configures the DOT, GRAPHML and MERMAID formats.
+	Graph                *GraphOptions `protobuf:"bytes,5,opt,name=graph,proto3" json:"graph,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{37}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (m *ExportRequest) GetBaseUrl() string {
+	if m != nil {
+		return m.BaseUrl
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetGraph() *GraphOptions {
+	if m != nil {
+		return m.Graph
+	}
+	return nil
+}
+
+// GraphOptions configures how a crawl is exported as a graph.
+type GraphOptions struct {
+	// max_depth is the deepest level of the site tree that is drawn, where the
+	// root is at depth 0. Deeper pages are folded into their ancestor. 0 draws
+	// every level.
+	MaxDepth int32 `protobuf:"varint,1,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// collapse_siblings is the most children a node is drawn with. The rest are
+	// collapsed into a single node that counts them. 0 doesn't collapse any
+	// children.
+	CollapseSiblings int32 `protobuf:"varint,2,opt,name=collapse_siblings,json=collapseSiblings,proto3" json:"collapse_siblings,omitempty"`
+	// color_by_status colors the nodes by the HTTP status of their page.
+	ColorByStatus bool `protobuf:"varint,3,opt,name=color_by_status,json=colorByStatus,proto3" json:"color_by_status,omitempty"`
+	// links draws the links between pages as well as the site tree.
+	Links                bool     `protobuf:"varint,4,opt,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphOptions) Reset()         { *m = GraphOptions{} }
+func (m *GraphOptions) String() string { return proto.CompactTextString(m) }
+func (*GraphOptions) ProtoMessage()    {}
+func (*GraphOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{38}
+}
+
+func (m *GraphOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphOptions.Unmarshal(m, b)
+}
+func (m *GraphOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphOptions.Marshal(b, m, deterministic)
+}
+func (m *GraphOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphOptions.Merge(m, src)
+}
+func (m *GraphOptions) XXX_Size() int {
+	return xxx_messageInfo_GraphOptions.Size(m)
+}
+func (m *GraphOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphOptions proto.InternalMessageInfo
+
+func (m *GraphOptions) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *GraphOptions) GetCollapseSiblings() int32 {
+	if m != nil {
+		return m.CollapseSiblings
+	}
+	return 0
+}
+
+func (m *GraphOptions) GetColorByStatus() bool {
+	if m != nil {
+		return m.ColorByStatus
+	}
+	return false
+}
+
+func (m *GraphOptions) GetLinks() bool {
+	if m != nil {
+		return m.Links
+	}
+	return false
+}
+
+// ExportResponse contains a chunk of an exported file. Each file is sent in
+// order as one or more chunks, and the chunks of a file are appended to each
+// other.
+type ExportResponse struct {
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	File                 *ExportFile `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{39}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExportResponse) GetFile() *ExportFile {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+// ExportFile is a single exported file.
+type ExportFile struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportFile) Reset()         { *m = ExportFile{} }
+func (m *ExportFile) String() string { return proto.CompactTextString(m) }
+func (*ExportFile) ProtoMessage()    {}
+func (*ExportFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{40}
+}
+
+func (m *ExportFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportFile.Unmarshal(m, b)
+}
+func (m *ExportFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportFile.Marshal(b, m, deterministic)
+}
+func (m *ExportFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportFile.Merge(m, src)
+}
+func (m *ExportFile) XXX_Size() int {
+	return xxx_messageInfo_ExportFile.Size(m)
+}
+func (m *ExportFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportFile proto.InternalMessageInfo
+
+func (m *ExportFile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExportFile) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+// AssetReportRequest is sent to the service to summarize the assets used by a
+// crawl. If the ID is empty then the most recent crawl of the URL is used.
+type AssetReportRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssetReportRequest) Reset()         { *m = AssetReportRequest{} }
+func (m *AssetReportRequest) String() string { return proto.CompactTextString(m) }
+func (*AssetReportRequest) ProtoMessage()    {}
+func (*AssetReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{41}
+}
+
+func (m *AssetReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetReportRequest.Unmarshal(m, b)
+}
+func (m *AssetReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetReportRequest.Marshal(b, m, deterministic)
+}
+func (m *AssetReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetReportRequest.Merge(m, src)
+}
+func (m *AssetReportRequest) XXX_Size() int {
+	return xxx_messageInfo_AssetReportRequest.Size(m)
+}
+func (m *AssetReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetReportRequest proto.InternalMessageInfo
+
+func (m *AssetReportRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *AssetReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// AssetReportResponse lists the assets used by a crawl and the weight of each
+// page.
+type AssetReportResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// checked is true if the crawl requested the assets, so their status and
+	// size are known.
+	Checked bool `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// assets are sorted by the number of pages that use them, most first.
+	Assets []*AssetUsage `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`
+	// pages are sorted by their total size, heaviest first.
+	Pages                []*PageWeight `protobuf:"bytes,4,rep,name=pages,proto3" json:"pages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AssetReportResponse) Reset()         { *m = AssetReportResponse{} }
+func (m *AssetReportResponse) String() string { return proto.CompactTextString(m) }
+func (*AssetReportResponse) ProtoMessage()    {}
+func (*AssetReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{42}
+}
+
+func (m *AssetReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetReportResponse.Unmarshal(m, b)
+}
+func (m *AssetReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetReportResponse.Marshal(b, m, deterministic)
+}
+func (m *AssetReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetReportResponse.Merge(m, src)
+}
+func (m *AssetReportResponse) XXX_Size() int {
+	return xxx_messageInfo_AssetReportResponse.Size(m)
+}
+func (m *AssetReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetReportResponse proto.InternalMessageInfo
+
+func (m *AssetReportResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AssetReportResponse) GetChecked() bool {
+	if m != nil {
+		return m.Checked
+	}
+	return false
+}
+
+func (m *AssetReportResponse) GetAssets() []*AssetUsage {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+func (m *AssetReportResponse) GetPages() []*PageWeight {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+// AssetUsage describes an asset and how many pages use it.
+type AssetUsage struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// type is one of image, script, stylesheet or media.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// third_party is true if the asset isn't on the crawled site.
+	ThirdParty bool `protobuf:"varint,3,opt,name=third_party,json=thirdParty,proto3" json:"third_party,omitempty"`
+	// pages is the number of pages that use the asset.
+	Pages int32 `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	// status, error, content_type and size are only set if the asset was
+	// checked. size is -1 if the server didn't report it.
+	Status      int32  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Error       string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	ContentType string `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	// broken is true if the asset was checked and couldn't be fetched.
+	Broken               bool     `protobuf:"varint,9,opt,name=broken,proto3" json:"broken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssetUsage) Reset()         { *m = AssetUsage{} }
+func (m *AssetUsage) String() string { return proto.CompactTextString(m) }
+func (*AssetUsage) ProtoMessage()    {}
+func (*AssetUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{43}
+}
+
+func (m *AssetUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetUsage.Unmarshal(m, b)
+}
+func (m *AssetUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetUsage.Marshal(b, m, deterministic)
+}
+func (m *AssetUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetUsage.Merge(m, src)
+}
+func (m *AssetUsage) XXX_Size() int {
+	return xxx_messageInfo_AssetUsage.Size(m)
+}
+func (m *AssetUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetUsage proto.InternalMessageInfo
+
+func (m *AssetUsage) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *AssetUsage) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AssetUsage) GetThirdParty() bool {
+	if m != nil {
+		return m.ThirdParty
+	}
+	return false
+}
+
+func (m *AssetUsage) GetPages() int32 {
+	if m != nil {
+		return m.Pages
+	}
+	return 0
+}
+
+func (m *AssetUsage) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *AssetUsage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AssetUsage) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *AssetUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *AssetUsage) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+// PageWeight is the total size of a page and the assets it uses.
+type PageWeight struct {
+	Url      string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	HtmlSize int64  `protobuf:"varint,2,opt,name=html_size,json=htmlSize,proto3" json:"html_size,omitempty"`
+	// asset_size is the total size of the page's assets whose size is known,
+	// and unknown_sizes is the number of assets whose size isn't known.
+	AssetSize            int64    `protobuf:"varint,3,opt,name=asset_size,json=assetSize,proto3" json:"asset_size,omitempty"`
+	UnknownSizes         int32    `protobuf:"varint,4,opt,name=unknown_sizes,json=unknownSizes,proto3" json:"unknown_sizes,omitempty"`
+	TotalSize            int64    `protobuf:"varint,5,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Assets               int32    `protobuf:"varint,6,opt,name=assets,proto3" json:"assets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageWeight) Reset()         { *m = PageWeight{} }
+func (m *PageWeight) String() string { return proto.CompactTextString(m) }
+func (*PageWeight) ProtoMessage()    {}
+func (*PageWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{44}
+}
+
+func (m *PageWeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageWeight.Unmarshal(m, b)
+}
+func (m *PageWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageWeight.Marshal(b, m, deterministic)
+}
+func (m *PageWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageWeight.Merge(m, src)
+}
+func (m *PageWeight) XXX_Size() int {
+	return xxx_messageInfo_PageWeight.Size(m)
+}
+func (m *PageWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageWeight proto.InternalMessageInfo
+
+func (m *PageWeight) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PageWeight) GetHtmlSize() int64 {
+	if m != nil {
+		return m.HtmlSize
+	}
+	return 0
+}
+
+func (m *PageWeight) GetAssetSize() int64 {
+	if m != nil {
+		return m.AssetSize
+	}
+	return 0
+}
+
+func (m *PageWeight) GetUnknownSizes() int32 {
+	if m != nil {
+		return m.UnknownSizes
+	}
+	return 0
+}
+
+func (m *PageWeight) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *PageWeight) GetAssets() int32 {
+	if m != nil {
+		return m.Assets
+	}
+	return 0
+}
+
+// ExternalLinkReportRequest is sent to the service to summarize the links from
+// a crawl to other sites. If the ID is empty then the most recent crawl of the
+// URL is used.
+type ExternalLinkReportRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalLinkReportRequest) Reset()         { *m = ExternalLinkReportRequest{} }
+func (m *ExternalLinkReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExternalLinkReportRequest) ProtoMessage()    {}
+func (*ExternalLinkReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{45}
+}
+
+func (m *ExternalLinkReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalLinkReportRequest.Unmarshal(m, b)
+}
+func (m *ExternalLinkReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalLinkReportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExternalLinkReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalLinkReportRequest.Merge(m, src)
+}
+func (m *ExternalLinkReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExternalLinkReportRequest.Size(m)
+}
+func (m *ExternalLinkReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalLinkReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalLinkReportRequest proto.InternalMessageInfo
+
+func (m *ExternalLinkReportRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ExternalLinkReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// ExternalLinkReportResponse lists the other sites that a crawl linked to.
+type ExternalLinkReportResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// checked is true if the crawl requested the external links.
+	Checked bool `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// domains are sorted by the number of pages that link to them, most first.
+	Domains []*ExternalDomain `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"`
+	// links are sorted by the number of pages that link to them, most first.
+	Links                []*ExternalLink `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExternalLinkReportResponse) Reset()         { *m = ExternalLinkReportResponse{} }
+func (m *ExternalLinkReportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExternalLinkReportResponse) ProtoMessage()    {}
+func (*ExternalLinkReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{46}
+}
+
+func (m *ExternalLinkReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalLinkReportResponse.Unmarshal(m, b)
+}
+func (m *ExternalLinkReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalLinkReportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExternalLinkReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalLinkReportResponse.Merge(m, src)
+}
+func (m *ExternalLinkReportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExternalLinkReportResponse.Size(m)
+}
+func (m *ExternalLinkReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalLinkReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalLinkReportResponse proto.InternalMessageInfo
+
+func (m *ExternalLinkReportResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExternalLinkReportResponse) GetChecked() bool {
+	if m != nil {
+		return m.Checked
+	}
+	return false
+}
+
+func (m *ExternalLinkReportResponse) GetDomains() []*ExternalDomain {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+func (m *ExternalLinkReportResponse) GetLinks() []*ExternalLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+// ExternalDomain summarizes the links to a single host.
+type ExternalDomain struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// links is the number of different URLs on the host that are linked to, and
+	// pages is the number of pages that link to any of them.
+	Links int32 `protobuf:"varint,2,opt,name=links,proto3" json:"links,omitempty"`
+	Pages int32 `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+	// broken is the number of the links that were checked and are broken.
+	Broken               int32    `protobuf:"varint,4,opt,name=broken,proto3" json:"broken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalDomain) Reset()         { *m = ExternalDomain{} }
+func (m *ExternalDomain) String() string { return proto.CompactTextString(m) }
+func (*ExternalDomain) ProtoMessage()    {}
+func (*ExternalDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{47}
+}
+
+func (m *ExternalDomain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalDomain.Unmarshal(m, b)
+}
+func (m *ExternalDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalDomain.Marshal(b, m, deterministic)
+}
+func (m *ExternalDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalDomain.Merge(m, src)
+}
+func (m *ExternalDomain) XXX_Size() int {
+	return xxx_messageInfo_ExternalDomain.Size(m)
+}
+func (m *ExternalDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalDomain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalDomain proto.InternalMessageInfo
+
+func (m *ExternalDomain) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ExternalDomain) GetLinks() int32 {
+	if m != nil {
+		return m.Links
+	}
+	return 0
+}
+
+func (m *ExternalDomain) GetPages() int32 {
+	if m != nil {
+		return m.Pages
+	}
+	return 0
+}
+
+func (m *ExternalDomain) GetBroken() int32 {
+	if m != nil {
+		return m.Broken
+	}
+	return 0
+}
+
+// ExternalLink is a URL on another site and the pages that link to it.
+type ExternalLink struct {
+	Url   string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Pages []string `protobuf:"bytes,2,rep,name=pages,proto3" json:"pages,omitempty"`
+	// checked is true if the link was requested, in which case status and
+	// error describe the response.
+	Checked              bool     `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	Status               int32    `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Broken               bool     `protobuf:"varint,6,opt,name=broken,proto3" json:"broken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalLink) Reset()         { *m = ExternalLink{} }
+func (m *ExternalLink) String() string { return proto.CompactTextString(m) }
+func (*ExternalLink) ProtoMessage()    {}
+func (*ExternalLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{48}
+}
+
+func (m *ExternalLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalLink.Unmarshal(m, b)
+}
+func (m *ExternalLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalLink.Marshal(b, m, deterministic)
+}
+func (m *ExternalLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalLink.Merge(m, src)
+}
+func (m *ExternalLink) XXX_Size() int {
+	return xxx_messageInfo_ExternalLink.Size(m)
+}
+func (m *ExternalLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalLink proto.InternalMessageInfo
+
+func (m *ExternalLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ExternalLink) GetPages() []string {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+func (m *ExternalLink) GetChecked() bool {
+	if m != nil {
+		return m.Checked
+	}
+	return false
+}
+
+func (m *ExternalLink) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ExternalLink) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExternalLink) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+// AuditRequest is sent to the service to audit a crawl. If the ID is empty
+// then the most recent crawl of the URL is used. Thresholds that are zero use
+// the service's defaults.
+type AuditRequest struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id  string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// max_url_length is the length above which a URL is too long.
+	MaxUrlLength int32 `protobuf:"varint,3,opt,name=max_url_length,json=maxUrlLength,proto3" json:"max_url_length,omitempty"`
+	// min_words is the number of words below which a page's content is thin.
+	MinWords             int32    `protobuf:"varint,4,opt,name=min_words,json=minWords,proto3" json:"min_words,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditRequest) Reset()         { *m = AuditRequest{} }
+func (m *AuditRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditRequest) ProtoMessage()    {}
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{49}
+}
+
+func (m *AuditRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditRequest.Unmarshal(m, b)
+}
+func (m *AuditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRequest.Merge(m, src)
+}
+func (m *AuditRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditRequest.Size(m)
+}
+func (m *AuditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRequest proto.InternalMessageInfo
+
+func (m *AuditRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *AuditRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditRequest) GetMaxUrlLength() int32 {
+	if m != nil {
+		return m.MaxUrlLength
+	}
+	return 0
+}
+
+func (m *AuditRequest) GetMinWords() int32 {
+	if m != nil {
+		return m.MinWords
+	}
+	return 0
+}
+
+// AuditResponse lists the issues found in a crawl.
+type AuditResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pages is the number of pages that were audited. Only HTML pages that were
+	// fetched successfully are audited.
+	Pages int32 `protobuf:"varint,2,opt,name=pages,proto3" json:"pages,omitempty"`
+	// issues are the kinds of issue that were found, in the order of
+	// AuditIssueType.
+	Issues               []*AuditIssue `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditResponse) Reset()         { *m = AuditResponse{} }
+func (m *AuditResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditResponse) ProtoMessage()    {}
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{50}
+}
+
+func (m *AuditResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditResponse.Unmarshal(m, b)
+}
+func (m *AuditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditResponse.Marshal(b, m, deterministic)
+}
+func (m *AuditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditResponse.Merge(m, src)
+}
+func (m *AuditResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditResponse.Size(m)
+}
+func (m *AuditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditResponse proto.InternalMessageInfo
+
+func (m *AuditResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditResponse) GetPages() int32 {
+	if m != nil {
+		return m.Pages
+	}
+	return 0
+}
+
+func (m *AuditResponse) GetIssues() []*AuditIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+// AuditIssue lists the pages with a single kind of issue.
+type AuditIssue struct {
+	Type                 AuditIssueType  `protobuf:"varint,1,opt,name=type,proto3,enum=crawler.v1.AuditIssueType" json:"type,omitempty"`
+	Pages                []*AuditFinding `protobuf:"bytes,2,rep,name=pages,proto3" json:"pages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AuditIssue) Reset()         { *m = AuditIssue{} }
+func (m *AuditIssue) String() string { return proto.CompactTextString(m) }
+func (*AuditIssue) ProtoMessage()    {}
+func (*AuditIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{51}
+}
+
+func (m *AuditIssue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditIssue.Unmarshal(m, b)
+}
+func (m *AuditIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditIssue.Marshal(b, m, deterministic)
+}
+func (m *AuditIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditIssue.Merge(m, src)
+}
+func (m *AuditIssue) XXX_Size() int {
+	return xxx_messageInfo_AuditIssue.Size(m)
+}
+func (m *AuditIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditIssue proto.InternalMessageInfo
+
+func (m *AuditIssue) GetType() AuditIssueType {
+	if m != nil {
+		return m.Type
+	}
+	return AuditIssueType_AUDIT_ISSUE_UNSPECIFIED
+}
+
+func (m *AuditIssue) GetPages() []*AuditFinding {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+// AuditFinding is an issue found on a single page. detail explains the issue,
+// such as the duplicated title or the number of words.
+type AuditFinding struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Detail               string   `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditFinding) Reset()         { *m = AuditFinding{} }
+func (m *AuditFinding) String() string { return proto.CompactTextString(m) }
+func (*AuditFinding) ProtoMessage()    {}
+func (*AuditFinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{52}
+}
+
+func (m *AuditFinding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditFinding.Unmarshal(m, b)
+}
+func (m *AuditFinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditFinding.Marshal(b, m, deterministic)
+}
+func (m *AuditFinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditFinding.Merge(m, src)
+}
+func (m *AuditFinding) XXX_Size() int {
+	return xxx_messageInfo_AuditFinding.Size(m)
+}
+func (m *AuditFinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditFinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditFinding proto.InternalMessageInfo
+
+func (m *AuditFinding) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *AuditFinding) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+// CanonicalReportRequest is sent to the service to report on the canonical and
+// hreflang links of a crawl. If the ID is empty then the most recent crawl of
+// the URL is used.
+type CanonicalReportRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CanonicalReportRequest) Reset()         { *m = CanonicalReportRequest{} }
+func (m *CanonicalReportRequest) String() string { return proto.CompactTextString(m) }
+func (*CanonicalReportRequest) ProtoMessage()    {}
+func (*CanonicalReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{53}
+}
+
+func (m *CanonicalReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CanonicalReportRequest.Unmarshal(m, b)
+}
+func (m *CanonicalReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CanonicalReportRequest.Marshal(b, m, deterministic)
+}
+func (m *CanonicalReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalReportRequest.Merge(m, src)
+}
+func (m *CanonicalReportRequest) XXX_Size() int {
+	return xxx_messageInfo_CanonicalReportRequest.Size(m)
+}
+func (m *CanonicalReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalReportRequest proto.InternalMessageInfo
+
+func (m *CanonicalReportRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CanonicalReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// CanonicalReportResponse lists the problems with a crawl's canonical and
+// hreflang links.
+type CanonicalReportResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// chains are the pages whose canonical URL has a canonical URL of its own,
+	// sorted by the URL of the first page.
+	Chains []*CanonicalChain `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+	// broken are the pages whose canonical URL didn't respond with 200 OK,
+	// sorted by the page's URL.
+	Broken []*BrokenCanonical `protobuf:"bytes,3,rep,name=broken,proto3" json:"broken,omitempty"`
+	// hreflang_clusters are the groups of pages that link to each other as
+	// versions in other languages, where some of the links aren't returned.
+	HreflangClusters     []*HreflangCluster `protobuf:"bytes,4,rep,name=hreflang_clusters,json=hreflangClusters,proto3" json:"hreflang_clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CanonicalReportResponse) Reset()         { *m = CanonicalReportResponse{} }
+func (m *CanonicalReportResponse) String() string { return proto.CompactTextString(m) }
+func (*CanonicalReportResponse) ProtoMessage()    {}
+func (*CanonicalReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{54}
+}
+
+func (m *CanonicalReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CanonicalReportResponse.Unmarshal(m, b)
+}
+func (m *CanonicalReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CanonicalReportResponse.Marshal(b, m, deterministic)
+}
+func (m *CanonicalReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalReportResponse.Merge(m, src)
+}
+func (m *CanonicalReportResponse) XXX_Size() int {
+	return xxx_messageInfo_CanonicalReportResponse.Size(m)
+}
+func (m *CanonicalReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalReportResponse proto.InternalMessageInfo
+
+func (m *CanonicalReportResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CanonicalReportResponse) GetChains() []*CanonicalChain {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *CanonicalReportResponse) GetBroken() []*BrokenCanonical {
+	if m != nil {
+		return m.Broken
+	}
+	return nil
+}
+
+func (m *CanonicalReportResponse) GetHreflangClusters() []*HreflangCluster {
+	if m != nil {
+		return m.HreflangClusters
+	}
+	return nil
+}
+
+// CanonicalChain is a page followed by the canonical URLs that lead on from
+// it. loop is true if the last URL points back to an earlier one.
+type CanonicalChain struct {
+	Urls                 []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	Loop                 bool     `protobuf:"varint,2,opt,name=loop,proto3" json:"loop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CanonicalChain) Reset()         { *m = CanonicalChain{} }
+func (m *CanonicalChain) String() string { return proto.CompactTextString(m) }
+func (*CanonicalChain) ProtoMessage()    {}
+func (*CanonicalChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{55}
+}
+
+func (m *CanonicalChain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CanonicalChain.Unmarshal(m, b)
+}
+func (m *CanonicalChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CanonicalChain.Marshal(b, m, deterministic)
+}
+func (m *CanonicalChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanonicalChain.Merge(m, src)
+}
+func (m *CanonicalChain) XXX_Size() int {
+	return xxx_messageInfo_CanonicalChain.Size(m)
+}
+func (m *CanonicalChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanonicalChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanonicalChain proto.InternalMessageInfo
+
+func (m *CanonicalChain) GetUrls() []string {
+	if m != nil {
+		return m.Urls
+	}
+	return nil
+}
+
+func (m *CanonicalChain) GetLoop() bool {
+	if m != nil {
+		return m.Loop
+	}
+	return false
+}
+
+// BrokenCanonical is a page whose canonical URL didn't respond with 200 OK.
+// crawled is false if the canonical URL wasn't fetched by the crawl, in which
+// case its status isn't known.
+type BrokenCanonical struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Canonical            string   `protobuf:"bytes,2,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	Crawled              bool     `protobuf:"varint,3,opt,name=crawled,proto3" json:"crawled,omitempty"`
+	Status               int32    `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BrokenCanonical) Reset()         { *m = BrokenCanonical{} }
+func (m *BrokenCanonical) String() string { return proto.CompactTextString(m) }
+func (*BrokenCanonical) ProtoMessage()    {}
+func (*BrokenCanonical) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{56}
+}
+
+func (m *BrokenCanonical) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BrokenCanonical.Unmarshal(m, b)
+}
+func (m *BrokenCanonical) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BrokenCanonical.Marshal(b, m, deterministic)
+}
+func (m *BrokenCanonical) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BrokenCanonical.Merge(m, src)
+}
+func (m *BrokenCanonical) XXX_Size() int {
+	return xxx_messageInfo_BrokenCanonical.Size(m)
+}
+func (m *BrokenCanonical) XXX_DiscardUnknown() {
+	xxx_messageInfo_BrokenCanonical.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BrokenCanonical proto.InternalMessageInfo
+
+func (m *BrokenCanonical) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *BrokenCanonical) GetCanonical() string {
+	if m != nil {
+		return m.Canonical
+	}
+	return ""
+}
+
+func (m *BrokenCanonical) GetCrawled() bool {
+	if m != nil {
+		return m.Crawled
+	}
+	return false
+}
+
+func (m *BrokenCanonical) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *BrokenCanonical) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// HreflangCluster is a group of pages that link to each other as versions in
+// other languages.
+type HreflangCluster struct {
+	// pages are the versions in the cluster sorted by URL. crawled is false for
+	// versions that weren't fetched by the crawl, whose links can't be checked.
+	Pages []*HreflangPage `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"`
+	// missing are the links that the version they point to doesn't return.
+	Missing              []*MissingReturnLink `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HreflangCluster) Reset()         { *m = HreflangCluster{} }
+func (m *HreflangCluster) String() string { return proto.CompactTextString(m) }
+func (*HreflangCluster) ProtoMessage()    {}
+func (*HreflangCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{57}
+}
+
+func (m *HreflangCluster) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HreflangCluster.Unmarshal(m, b)
+}
+func (m *HreflangCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HreflangCluster.Marshal(b, m, deterministic)
+}
+func (m *HreflangCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HreflangCluster.Merge(m, src)
+}
+func (m *HreflangCluster) XXX_Size() int {
+	return xxx_messageInfo_HreflangCluster.Size(m)
+}
+func (m *HreflangCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_HreflangCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HreflangCluster proto.InternalMessageInfo
+
+func (m *HreflangCluster) GetPages() []*HreflangPage {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+func (m *HreflangCluster) GetMissing() []*MissingReturnLink {
+	if m != nil {
+		return m.Missing
+	}
+	return nil
+}
+
+// HreflangPage is a single version of a page in a hreflang cluster, along with
+// the languages it was linked to as.
+type HreflangPage struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Langs                []string `protobuf:"bytes,2,rep,name=langs,proto3" json:"langs,omitempty"`
+	Crawled              bool     `protobuf:"varint,3,opt,name=crawled,proto3" json:"crawled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HreflangPage) Reset()         { *m = HreflangPage{} }
+func (m *HreflangPage) String() string { return proto.CompactTextString(m) }
+func (*HreflangPage) ProtoMessage()    {}
+func (*HreflangPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{58}
+}
+
+func (m *HreflangPage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HreflangPage.Unmarshal(m, b)
+}
+func (m *HreflangPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HreflangPage.Marshal(b, m, deterministic)
+}
+func (m *HreflangPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HreflangPage.Merge(m, src)
+}
+func (m *HreflangPage) XXX_Size() int {
+	return xxx_messageInfo_HreflangPage.Size(m)
+}
+func (m *HreflangPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_HreflangPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HreflangPage proto.InternalMessageInfo
+
+func (m *HreflangPage) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *HreflangPage) GetLangs() []string {
+	if m != nil {
+		return m.Langs
+	}
+	return nil
+}
+
+func (m *HreflangPage) GetCrawled() bool {
+	if m != nil {
+		return m.Crawled
+	}
+	return false
+}
+
+// MissingReturnLink is a hreflang link from a page to its version in lang,
+// which doesn't have a hreflang link back.
+type MissingReturnLink struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Lang                 string   `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MissingReturnLink) Reset()         { *m = MissingReturnLink{} }
+func (m *MissingReturnLink) String() string { return proto.CompactTextString(m) }
+func (*MissingReturnLink) ProtoMessage()    {}
+func (*MissingReturnLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{59}
+}
+
+func (m *MissingReturnLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissingReturnLink.Unmarshal(m, b)
+}
+func (m *MissingReturnLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissingReturnLink.Marshal(b, m, deterministic)
+}
+func (m *MissingReturnLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissingReturnLink.Merge(m, src)
+}
+func (m *MissingReturnLink) XXX_Size() int {
+	return xxx_messageInfo_MissingReturnLink.Size(m)
+}
+func (m *MissingReturnLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissingReturnLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissingReturnLink proto.InternalMessageInfo
+
+func (m *MissingReturnLink) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MissingReturnLink) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MissingReturnLink) GetLang() string {
+	if m != nil {
+		return m.Lang
+	}
+	return ""
+}
+
+// DuplicateReportRequest is sent to the service to find the pages of a crawl
+// with the same or nearly the same text. If the ID is empty then the most
+// recent crawl of the URL is used. Pages are near duplicates if the SimHashes
+// of their text differ by at most max_distance bits, 0 uses the service's
+// default.
+type DuplicateReportRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	MaxDistance          int32    `protobuf:"varint,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DuplicateReportRequest) Reset()         { *m = DuplicateReportRequest{} }
+func (m *DuplicateReportRequest) String() string { return proto.CompactTextString(m) }
+func (*DuplicateReportRequest) ProtoMessage()    {}
+func (*DuplicateReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{60}
+}
+
+func (m *DuplicateReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateReportRequest.Unmarshal(m, b)
+}
+func (m *DuplicateReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateReportRequest.Marshal(b, m, deterministic)
+}
+func (m *DuplicateReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateReportRequest.Merge(m, src)
+}
+func (m *DuplicateReportRequest) XXX_Size() int {
+	return xxx_messageInfo_DuplicateReportRequest.Size(m)
+}
+func (m *DuplicateReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateReportRequest proto.InternalMessageInfo
+
+func (m *DuplicateReportRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DuplicateReportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DuplicateReportRequest) GetMaxDistance() int32 {
+	if m != nil {
+		return m.MaxDistance
+	}
+	return 0
+}
+
+// DuplicateReportResponse lists the clusters of duplicate pages.
+type DuplicateReportResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pages is the number of pages that were compared. Only HTML pages with
+	// some text are compared.
+	Pages int32 `protobuf:"varint,2,opt,name=pages,proto3" json:"pages,omitempty"`
+	// clusters are sorted by their number of pages, most first.
+	Clusters             []*DuplicateCluster `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DuplicateReportResponse) Reset()         { *m = DuplicateReportResponse{} }
+func (m *DuplicateReportResponse) String() string { return proto.CompactTextString(m) }
+func (*DuplicateReportResponse) ProtoMessage()    {}
+func (*DuplicateReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{61}
+}
+
+func (m *DuplicateReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateReportResponse.Unmarshal(m, b)
+}
+func (m *DuplicateReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateReportResponse.Marshal(b, m, deterministic)
+}
+func (m *DuplicateReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateReportResponse.Merge(m, src)
+}
+func (m *DuplicateReportResponse) XXX_Size() int {
+	return xxx_messageInfo_DuplicateReportResponse.Size(m)
+}
+func (m *DuplicateReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateReportResponse proto.InternalMessageInfo
+
+func (m *DuplicateReportResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DuplicateReportResponse) GetPages() int32 {
+	if m != nil {
+		return m.Pages
+	}
+	return 0
+}
+
+func (m *DuplicateReportResponse) GetClusters() []*DuplicateCluster {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+// DuplicateCluster is a group of pages with the same or nearly the same text.
+// exact is true if every page has exactly the same text.
+type DuplicateCluster struct {
+	Exact                bool             `protobuf:"varint,1,opt,name=exact,proto3" json:"exact,omitempty"`
+	Pages                []*DuplicatePage `protobuf:"bytes,2,rep,name=pages,proto3" json:"pages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DuplicateCluster) Reset()         { *m = DuplicateCluster{} }
+func (m *DuplicateCluster) String() string { return proto.CompactTextString(m) }
+func (*DuplicateCluster) ProtoMessage()    {}
+func (*DuplicateCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{62}
+}
+
+func (m *DuplicateCluster) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateCluster.Unmarshal(m, b)
+}
+func (m *DuplicateCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateCluster.Marshal(b, m, deterministic)
+}
+func (m *DuplicateCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateCluster.Merge(m, src)
+}
+func (m *DuplicateCluster) XXX_Size() int {
+	return xxx_messageInfo_DuplicateCluster.Size(m)
+}
+func (m *DuplicateCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateCluster proto.InternalMessageInfo
+
+func (m *DuplicateCluster) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+func (m *DuplicateCluster) GetPages() []*DuplicatePage {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+// DuplicatePage is a page in a duplicate cluster. distance is the number of
+// bits by which its SimHash differs from the first page of the cluster.
+// skipped is true if the crawl didn't follow the page's links because it was a
+// duplicate.
+type DuplicatePage struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Distance             int32    `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Skipped              bool     `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DuplicatePage) Reset()         { *m = DuplicatePage{} }
+func (m *DuplicatePage) String() string { return proto.CompactTextString(m) }
+func (*DuplicatePage) ProtoMessage()    {}
+func (*DuplicatePage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{63}
+}
+
+func (m *DuplicatePage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicatePage.Unmarshal(m, b)
+}
+func (m *DuplicatePage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicatePage.Marshal(b, m, deterministic)
+}
+func (m *DuplicatePage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicatePage.Merge(m, src)
+}
+func (m *DuplicatePage) XXX_Size() int {
+	return xxx_messageInfo_DuplicatePage.Size(m)
+}
+func (m *DuplicatePage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicatePage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicatePage proto.InternalMessageInfo
+
+func (m *DuplicatePage) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DuplicatePage) GetDistance() int32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *DuplicatePage) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+// StructuredDataRequest is sent to the service to get the structured data of
+// a crawl. If the ID is empty then the most recent crawl of the URL is used.
+// If type is set only the pages with a JSON-LD object or Microdata item of
+// that type, like "Product" or "https://schema.org/Product", are returned.
+type StructuredDataRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StructuredDataRequest) Reset()         { *m = StructuredDataRequest{} }
+func (m *StructuredDataRequest) String() string { return proto.CompactTextString(m) }
+func (*StructuredDataRequest) ProtoMessage()    {}
+func (*StructuredDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{64}
+}
+
+func (m *StructuredDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StructuredDataRequest.Unmarshal(m, b)
+}
+func (m *StructuredDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StructuredDataRequest.Marshal(b, m, deterministic)
+}
+func (m *StructuredDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredDataRequest.Merge(m, src)
+}
+func (m *StructuredDataRequest) XXX_Size() int {
+	return xxx_messageInfo_StructuredDataRequest.Size(m)
+}
+func (m *StructuredDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructuredDataRequest proto.InternalMessageInfo
+
+func (m *StructuredDataRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *StructuredDataRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StructuredDataRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// StructuredDataResponse lists the pages of a crawl that have structured data,
+// sorted by URL.
+type StructuredDataResponse struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pages                []*PageStructuredData `protobuf:"bytes,2,rep,name=pages,proto3" json:"pages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StructuredDataResponse) Reset()         { *m = StructuredDataResponse{} }
+func (m *StructuredDataResponse) String() string { return proto.CompactTextString(m) }
+func (*StructuredDataResponse) ProtoMessage()    {}
+func (*StructuredDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{65}
+}
+
+func (m *StructuredDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StructuredDataResponse.Unmarshal(m, b)
+}
+func (m *StructuredDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StructuredDataResponse.Marshal(b, m, deterministic)
+}
+func (m *StructuredDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredDataResponse.Merge(m, src)
+}
+func (m *StructuredDataResponse) XXX_Size() int {
+	return xxx_messageInfo_StructuredDataResponse.Size(m)
+}
+func (m *StructuredDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructuredDataResponse proto.InternalMessageInfo
+
+func (m *StructuredDataResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StructuredDataResponse) GetPages() []*PageStructuredData {
+	if m != nil {
+		return m.Pages
+	}
+	return nil
+}
+
+// PageStructuredData is the structured data of a single page.
+type PageStructuredData struct {
+	Url                  string          `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Data                 *StructuredData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PageStructuredData) Reset()         { *m = PageStructuredData{} }
+func (m *PageStructuredData) String() string { return proto.CompactTextString(m) }
+func (*PageStructuredData) ProtoMessage()    {}
+func (*PageStructuredData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{66}
+}
+
+func (m *PageStructuredData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageStructuredData.Unmarshal(m, b)
+}
+func (m *PageStructuredData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageStructuredData.Marshal(b, m, deterministic)
+}
+func (m *PageStructuredData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageStructuredData.Merge(m, src)
+}
+func (m *PageStructuredData) XXX_Size() int {
+	return xxx_messageInfo_PageStructuredData.Size(m)
+}
+func (m *PageStructuredData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageStructuredData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageStructuredData proto.InternalMessageInfo
+
+func (m *PageStructuredData) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PageStructuredData) GetData() *StructuredData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// ResultsRequest is sent to the service to get the records extracted by a
+// crawl. If the ID is empty then the most recent crawl of the URL is used.
+type ResultsRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultsRequest) Reset()         { *m = ResultsRequest{} }
+func (m *ResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*ResultsRequest) ProtoMessage()    {}
+func (*ResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{67}
+}
+
+func (m *ResultsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultsRequest.Unmarshal(m, b)
+}
+func (m *ResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultsRequest.Marshal(b, m, deterministic)
+}
+func (m *ResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultsRequest.Merge(m, src)
+}
+func (m *ResultsRequest) XXX_Size() int {
+	return xxx_messageInfo_ResultsRequest.Size(m)
+}
+func (m *ResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultsRequest proto.InternalMessageInfo
+
+func (m *ResultsRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ResultsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// Record is the fields extracted from a single page, one for each rule that
+// applied to the page in the order of the rules. Records are sent sorted by
+// URL.
+type Record struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Fields               []*Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Record) Reset()         { *m = Record{} }
+func (m *Record) String() string { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()    {}
+func (*Record) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{68}
+}
+
+func (m *Record) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Record.Unmarshal(m, b)
+}
+func (m *Record) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Record.Marshal(b, m, deterministic)
+}
+func (m *Record) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record.Merge(m, src)
+}
+func (m *Record) XXX_Size() int {
+	return xxx_messageInfo_Record.Size(m)
+}
+func (m *Record) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record proto.InternalMessageInfo
+
+func (m *Record) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Record) GetFields() []*Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// Field is the values extracted from a page by a named rule, one for each
+// element that matched the rule's selector.
+type Field struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values               []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Field) Reset()         { *m = Field{} }
+func (m *Field) String() string { return proto.CompactTextString(m) }
+func (*Field) ProtoMessage()    {}
+func (*Field) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{69}
+}
+
+func (m *Field) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Field.Unmarshal(m, b)
+}
+func (m *Field) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Field.Marshal(b, m, deterministic)
+}
+func (m *Field) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Field.Merge(m, src)
+}
+func (m *Field) XXX_Size() int {
+	return xxx_messageInfo_Field.Size(m)
+}
+func (m *Field) XXX_DiscardUnknown() {
+	xxx_messageInfo_Field.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Field proto.InternalMessageInfo
+
+func (m *Field) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Field) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// SearchRequest is sent to the service to search the pages of a crawl. The
+// query's words must all appear in a page for it to match, and words in double
+// quotes must appear together as a phrase. If the ID is empty then the most
+// recent crawl of the URL is searched. If the limit is 0 then the service's
+// default is used.
+type SearchRequest struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Query                string   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{70}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// SearchResponse lists the pages that best match the query, and the number of
+// pages that matched it in total.
+type SearchResponse struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Total                int32           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Results              []*SearchResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{71}
+}
+
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse.Size(m)
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SearchResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// SearchResult is a page that matched a search. The score is the number of
+// times the query's words and phrases appear in the page, the snippet is the
+// text around their first appearance, and the tree path is the names of the
+// nodes from the root of the site tree down to the page.
+type SearchResult struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Snippet              string   `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Score                int32    `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	TreePath             []string `protobuf:"bytes,5,rep,name=tree_path,json=treePath,proto3" json:"tree_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchResult) Reset()         { *m = SearchResult{} }
+func (m *SearchResult) String() string { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()    {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84c7eabcfe7807d1, []int{72}
+}
+
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResult.Unmarshal(m, b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return xxx_messageInfo_SearchResult.Size(m)
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+func (m *SearchResult) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SearchResult) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SearchResult) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+func (m *SearchResult) GetScore() int32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SearchResult) GetTreePath() []string {
+	if m != nil {
+		return m.TreePath
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("crawler.v1.NofollowPolicy", NofollowPolicy_name, NofollowPolicy_value)
+	proto.RegisterEnum("crawler.v1.ChangeType", ChangeType_name, ChangeType_value)
+	proto.RegisterEnum("crawler.v1.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("crawler.v1.AuditIssueType", AuditIssueType_name, AuditIssueType_value)
+	proto.RegisterType((*StartRequest)(nil), "crawler.v1.StartRequest")
+	proto.RegisterType((*CrawlOptions)(nil), "crawler.v1.CrawlOptions")
+	proto.RegisterType((*ExtractionRule)(nil), "crawler.v1.ExtractionRule")
+	proto.RegisterType((*TrapOptions)(nil), "crawler.v1.TrapOptions")
+	proto.RegisterType((*MirrorOptions)(nil), "crawler.v1.MirrorOptions")
+	proto.RegisterType((*StartResponse)(nil), "crawler.v1.StartResponse")
+	proto.RegisterType((*StopRequest)(nil), "crawler.v1.StopRequest")
+	proto.RegisterType((*StopResponse)(nil), "crawler.v1.StopResponse")
+	proto.RegisterType((*ListRequest)(nil), "crawler.v1.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "crawler.v1.ListResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "crawler.v1.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "crawler.v1.DeleteResponse")
+	proto.RegisterType((*SiteTree)(nil), "crawler.v1.SiteTree")
+	proto.RegisterType((*SkippedURL)(nil), "crawler.v1.SkippedURL")
+	proto.RegisterType((*CrawlStats)(nil), "crawler.v1.CrawlStats")
+	proto.RegisterType((*Tree)(nil), "crawler.v1.Tree")
+	proto.RegisterType((*CreateScheduleRequest)(nil), "crawler.v1.CreateScheduleRequest")
+	proto.RegisterType((*CreateScheduleResponse)(nil), "crawler.v1.CreateScheduleResponse")
+	proto.RegisterType((*ListSchedulesRequest)(nil), "crawler.v1.ListSchedulesRequest")
+	proto.RegisterType((*ListSchedulesResponse)(nil), "crawler.v1.ListSchedulesResponse")
+	proto.RegisterType((*DeleteScheduleRequest)(nil), "crawler.v1.DeleteScheduleRequest")
+	proto.RegisterType((*DeleteScheduleResponse)(nil), "crawler.v1.DeleteScheduleResponse")
+	proto.RegisterType((*Schedule)(nil), "crawler.v1.Schedule")
+	proto.RegisterType((*ScheduledRun)(nil), "crawler.v1.ScheduledRun")
+	proto.RegisterType((*DiffRequest)(nil), "crawler.v1.DiffRequest")
+	proto.RegisterType((*DiffResponse)(nil), "crawler.v1.DiffResponse")
+	proto.RegisterType((*DiffTree)(nil), "crawler.v1.DiffTree")
+	proto.RegisterType((*PageChange)(nil), "crawler.v1.PageChange")
+	proto.RegisterType((*Page)(nil), "crawler.v1.Page")
+	proto.RegisterType((*StructuredData)(nil), "crawler.v1.StructuredData")
+	proto.RegisterType((*JsonLd)(nil), "crawler.v1.JsonLd")
+	proto.RegisterType((*MicrodataItem)(nil), "crawler.v1.MicrodataItem")
+	proto.RegisterType((*MicrodataProperty)(nil), "crawler.v1.MicrodataProperty")
+	proto.RegisterType((*MetaProperty)(nil), "crawler.v1.MetaProperty")
+	proto.RegisterType((*Hreflang)(nil), "crawler.v1.Hreflang")
+	proto.RegisterType((*SitemapReportRequest)(nil), "crawler.v1.SitemapReportRequest")
+	proto.RegisterType((*SitemapReportResponse)(nil), "crawler.v1.SitemapReportResponse")
+	proto.RegisterType((*ExportRequest)(nil), "crawler.v1.ExportRequest")
+	proto.RegisterType((*GraphOptions)(nil), "crawler.v1.GraphOptions")
+	proto.RegisterType((*ExportResponse)(nil), "crawler.v1.ExportResponse")
+	proto.RegisterType((*ExportFile)(nil), "crawler.v1.ExportFile")
+	proto.RegisterType((*AssetReportRequest)(nil), "crawler.v1.AssetReportRequest")
+	proto.RegisterType((*AssetReportResponse)(nil), "crawler.v1.AssetReportResponse")
+	proto.RegisterType((*AssetUsage)(nil), "crawler.v1.AssetUsage")
+	proto.RegisterType((*PageWeight)(nil), "crawler.v1.PageWeight")
+	proto.RegisterType((*ExternalLinkReportRequest)(nil), "crawler.v1.ExternalLinkReportRequest")
+	proto.RegisterType((*ExternalLinkReportResponse)(nil), "crawler.v1.ExternalLinkReportResponse")
+	proto.RegisterType((*ExternalDomain)(nil), "crawler.v1.ExternalDomain")
+	proto.RegisterType((*ExternalLink)(nil), "crawler.v1.ExternalLink")
+	proto.RegisterType((*AuditRequest)(nil), "crawler.v1.AuditRequest")
+	proto.RegisterType((*AuditResponse)(nil), "crawler.v1.AuditResponse")
+	proto.RegisterType((*AuditIssue)(nil), "crawler.v1.AuditIssue")
+	proto.RegisterType((*AuditFinding)(nil), "crawler.v1.AuditFinding")
+	proto.RegisterType((*CanonicalReportRequest)(nil), "crawler.v1.CanonicalReportRequest")
+	proto.RegisterType((*CanonicalReportResponse)(nil), "crawler.v1.CanonicalReportResponse")
+	proto.RegisterType((*CanonicalChain)(nil), "crawler.v1.CanonicalChain")
+	proto.RegisterType((*BrokenCanonical)(nil), "crawler.v1.BrokenCanonical")
+	proto.RegisterType((*HreflangCluster)(nil), "crawler.v1.HreflangCluster")
+	proto.RegisterType((*HreflangPage)(nil), "crawler.v1.HreflangPage")
+	proto.RegisterType((*MissingReturnLink)(nil), "crawler.v1.MissingReturnLink")
+	proto.RegisterType((*DuplicateReportRequest)(nil), "crawler.v1.DuplicateReportRequest")
+	proto.RegisterType((*DuplicateReportResponse)(nil), "crawler.v1.DuplicateReportResponse")
+	proto.RegisterType((*DuplicateCluster)(nil), "crawler.v1.DuplicateCluster")
+	proto.RegisterType((*DuplicatePage)(nil), "crawler.v1.DuplicatePage")
+	proto.RegisterType((*StructuredDataRequest)(nil), "crawler.v1.StructuredDataRequest")
+	proto.RegisterType((*StructuredDataResponse)(nil), "crawler.v1.StructuredDataResponse")
+	proto.RegisterType((*PageStructuredData)(nil), "crawler.v1.PageStructuredData")
+	proto.RegisterType((*ResultsRequest)(nil), "crawler.v1.ResultsRequest")
+	proto.RegisterType((*Record)(nil), "crawler.v1.Record")
+	proto.RegisterType((*Field)(nil), "crawler.v1.Field")
+	proto.RegisterType((*SearchRequest)(nil), "crawler.v1.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "crawler.v1.SearchResponse")
+	proto.RegisterType((*SearchResult)(nil), "crawler.v1.SearchResult")
+}
+
+func init() {
+	proto.RegisterFile("crawler.proto", fileDescriptor_84c7eabcfe7807d1)
+}
+
+var fileDescriptor_84c7eabcfe7807d1 = []byte{
+	// 3810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x5a, 0xcd, 0x8f, 0x1b, 0xc7,
+	0x72, 0x17, 0xbf, 0xc9, 0xe2, 0x87, 0xa8, 0x96, 0xb4, 0x1a, 0x51, 0xb6, 0xb5, 0x1a, 0xd9, 0x7e,
+	0x8a, 0x9e, 0xbd, 0x92, 0xd7, 0x2f, 0xb6, 0xdf, 0x4b, 0xfc, 0x90, 0xf5, 0x92, 0x92, 0x68, 0x73,
+	0x3f, 0x32, 0xe4, 0x5a, 0xcf, 0x41, 0x5e, 0x06, 0xb3, 0x9c, 0xde, 0xe5, 0x48, 0xc3, 0x19, 0x7a,
+	0xa6, 0x47, 0xbb, 0xeb, 0x4b, 0x1e, 0x10, 0xe4, 0x10, 0x20, 0xe7, 0x20, 0x97, 0x00, 0x41, 0x0e,
+	0x41, 0x0e, 0x41, 0x10, 0xe4, 0x90, 0x3f, 0x26, 0xc8, 0x2d, 0x40, 0xce, 0xf9, 0x13, 0x82, 0xea,
+	0x8f, 0xf9, 0x20, 0x87, 0x2b, 0xed, 0x3b, 0x71, 0xaa, 0xea, 0xd7, 0xdd, 0xd5, 0xdd, 0xd5, 0xd5,
+	0x55, 0xd5, 0x84, 0xf6, 0x34, 0xb0, 0xce, 0x5c, 0x1a, 0x6c, 0x2d, 0x02, 0x9f, 0xf9, 0x04, 0x14,
+	0xf9, 0xe6, 0xb3, 0xde, 0x07, 0xa7, 0xbe, 0x7f, 0xea, 0xd2, 0x27, 0x5c, 0x72, 0x1c, 0x9d, 0x3c,
+	0xb1, 0xa3, 0xc0, 0x62, 0x8e, 0xef, 0x09, 0x6c, 0xef, 0xfe, 0xb2, 0x9c, 0x39, 0x73, 0x1a, 0x32,
+	0x6b, 0xbe, 0x10, 0x00, 0x7d, 0x02, 0xad, 0x31, 0xb3, 0x02, 0x66, 0xd0, 0x1f, 0x23, 0x1a, 0x32,
+	0xd2, 0x85, 0x52, 0x14, 0xb8, 0x5a, 0x61, 0xb3, 0xf0, 0xa8, 0x61, 0xe0, 0x27, 0xd9, 0x86, 0x9a,
+	0xbf, 0xc0, 0x2e, 0x43, 0xad, 0xb8, 0x59, 0x78, 0xd4, 0xdc, 0xd6, 0xb6, 0x12, 0x05, 0xb6, 0x76,
+	0xf1, 0xf3, 0x40, 0xc8, 0x0d, 0x05, 0xd4, 0xff, 0xb1, 0x02, 0xad, 0xb4, 0x84, 0xf4, 0xa0, 0xbe,
+	0x08, 0x1c, 0x3f, 0x70, 0xd8, 0x05, 0xef, 0xbb, 0x62, 0xc4, 0x34, 0x79, 0x00, 0xad, 0x93, 0xc8,
+	0x75, 0xcd, 0x80, 0xf2, 0x7e, 0xf9, 0x28, 0x75, 0xa3, 0x89, 0x3c, 0x43, 0xb0, 0x10, 0x12, 0x85,
+	0xd4, 0x0c, 0x1d, 0x46, 0xe7, 0xd6, 0x22, 0xd4, 0x4a, 0x02, 0x12, 0x85, 0x74, 0x2c, 0x59, 0x44,
+	0x83, 0x9a, 0x15, 0x4c, 0x67, 0xce, 0x1b, 0xaa, 0x95, 0xb9, 0x54, 0x91, 0x64, 0x03, 0xaa, 0x01,
+	0x5d, 0xb8, 0xd6, 0x85, 0x56, 0xe1, 0xb3, 0x92, 0x14, 0x76, 0x2a, 0xbe, 0xcc, 0x13, 0xc7, 0xa5,
+	0xa1, 0xd6, 0xdd, 0x2c, 0x3d, 0x6a, 0x18, 0x4d, 0xc1, 0x7b, 0x86, 0x2c, 0xf2, 0x19, 0x54, 0xe7,
+	0x4e, 0x10, 0xf8, 0x81, 0x56, 0xe5, 0x53, 0xbf, 0x9b, 0x9e, 0xfa, 0x1e, 0x97, 0xa8, 0xb9, 0x4b,
+	0x20, 0xf6, 0x3a, 0x9d, 0xd1, 0xe9, 0x6b, 0xd3, 0x0a, 0x43, 0xca, 0x42, 0xad, 0x26, 0x54, 0xe5,
+	0xbc, 0x1d, 0xce, 0x22, 0x4f, 0xe1, 0x96, 0x80, 0xd0, 0x73, 0x46, 0x03, 0xcf, 0x72, 0x4d, 0xd7,
+	0xf1, 0x5e, 0x87, 0x5a, 0x9d, 0x43, 0x09, 0x97, 0x0d, 0xa4, 0x68, 0x84, 0x12, 0xf2, 0x4b, 0xb8,
+	0x9b, 0xc5, 0x9a, 0x0b, 0x1a, 0x98, 0x21, 0x9d, 0xfa, 0x9e, 0xad, 0x35, 0x36, 0x0b, 0x8f, 0x0a,
+	0xc6, 0x06, 0x4d, 0xb7, 0x38, 0xa4, 0xc1, 0x98, 0x4b, 0xc9, 0x17, 0x50, 0xf7, 0xfc, 0x13, 0xdf,
+	0x75, 0xfd, 0x33, 0x0d, 0x36, 0x0b, 0x8f, 0x3a, 0xdb, 0xbd, 0xf4, 0x24, 0xf6, 0xa5, 0xec, 0xd0,
+	0x77, 0x9d, 0xe9, 0x85, 0x11, 0x63, 0xc9, 0x47, 0xd0, 0x99, 0x5a, 0x9e, 0xef, 0x39, 0x53, 0xcb,
+	0x35, 0x59, 0x40, 0xa9, 0xd6, 0xe4, 0xea, 0xb5, 0x63, 0xee, 0x24, 0xa0, 0x94, 0xfc, 0x0c, 0xae,
+	0x87, 0xaf, 0x9d, 0x85, 0x69, 0x47, 0x0b, 0xd7, 0x99, 0x5a, 0x8c, 0x86, 0x5a, 0x8b, 0xe3, 0x3a,
+	0xc8, 0xee, 0xc7, 0x5c, 0xf2, 0x29, 0x90, 0x18, 0x63, 0xda, 0x4e, 0xc8, 0x2c, 0x6f, 0x4a, 0xb5,
+	0x36, 0xb7, 0x85, 0x1b, 0xb1, 0xa4, 0x2f, 0x05, 0xe4, 0x53, 0xa8, 0xb0, 0x00, 0xb7, 0xba, 0xc3,
+	0x17, 0xfe, 0x4e, 0x5a, 0xe7, 0x49, 0x60, 0x2d, 0xd4, 0xb2, 0x0b, 0x14, 0x79, 0x0a, 0x95, 0x20,
+	0xc2, 0x4d, 0xbc, 0xbe, 0x59, 0x7a, 0xd4, 0xcc, 0x4e, 0x71, 0x70, 0xce, 0x02, 0x6b, 0x8a, 0x70,
+	0x23, 0x72, 0xa9, 0x21, 0x80, 0xfa, 0x5f, 0x42, 0x27, 0x2b, 0x20, 0x04, 0xca, 0x9e, 0x35, 0xa7,
+	0xd2, 0xf6, 0xf9, 0x37, 0xda, 0x6d, 0x48, 0x5d, 0x3a, 0x65, 0x7e, 0xc0, 0xed, 0xb2, 0x61, 0xc4,
+	0x34, 0x79, 0x0f, 0x1a, 0x16, 0x63, 0x81, 0x73, 0x1c, 0x31, 0xca, 0x2d, 0xb2, 0x61, 0x24, 0x0c,
+	0x72, 0x1f, 0x9a, 0x51, 0xe0, 0x9a, 0x0b, 0x8b, 0xe1, 0xb6, 0x70, 0x9b, 0x6c, 0x18, 0x10, 0x05,
+	0xee, 0xa1, 0xe0, 0xe8, 0xff, 0x51, 0x80, 0x66, 0x6a, 0x26, 0x38, 0x94, 0xed, 0x84, 0xd6, 0xb1,
+	0x4b, 0x6d, 0xae, 0x42, 0xdd, 0x88, 0x69, 0x72, 0x0f, 0x1a, 0x73, 0xeb, 0xdc, 0xb4, 0xe9, 0x82,
+	0xcd, 0xb8, 0x1e, 0x15, 0xa3, 0x3e, 0xb7, 0xce, 0xfb, 0x48, 0xe3, 0x48, 0x28, 0x0c, 0xe8, 0x82,
+	0x5a, 0x4c, 0x9c, 0x8d, 0x8a, 0x01, 0x73, 0xeb, 0xdc, 0x10, 0x1c, 0xf2, 0x09, 0x10, 0x04, 0xfc,
+	0x18, 0xd1, 0xe0, 0xc2, 0x7c, 0x63, 0x05, 0x8e, 0xe5, 0xb1, 0x90, 0x6b, 0x54, 0x31, 0xba, 0x73,
+	0xeb, 0xfc, 0x4f, 0x51, 0xf0, 0xbd, 0xe4, 0xab, 0xb1, 0x16, 0xd6, 0x29, 0x0d, 0xb5, 0x4a, 0x3c,
+	0xd6, 0x21, 0xd2, 0xfa, 0x0f, 0xd0, 0xce, 0x98, 0x3d, 0xf9, 0x10, 0x3a, 0x88, 0x66, 0x3e, 0xb3,
+	0x5c, 0x33, 0x74, 0x7e, 0x12, 0xcb, 0x57, 0x32, 0x5a, 0x73, 0xeb, 0x7c, 0x82, 0xcc, 0xb1, 0xf3,
+	0x13, 0x25, 0x3a, 0xb4, 0x11, 0x85, 0xe7, 0x4c, 0x80, 0x8a, 0x1c, 0x84, 0x7a, 0xe3, 0x41, 0x43,
+	0x8c, 0xfe, 0x25, 0xb4, 0xa5, 0x27, 0x0a, 0x17, 0xbe, 0x17, 0x52, 0xd2, 0x81, 0xa2, 0x63, 0xcb,
+	0xdd, 0x28, 0x3a, 0x36, 0x9e, 0xe3, 0x1f, 0x23, 0x1a, 0x51, 0x5b, 0x7a, 0x08, 0x49, 0xe9, 0xf7,
+	0xa1, 0x39, 0x66, 0xfe, 0x62, 0xad, 0x07, 0xd3, 0x3b, 0xd0, 0x12, 0x00, 0xd1, 0xb1, 0xbe, 0x0d,
+	0xcd, 0x91, 0x13, 0xc6, 0x2e, 0xef, 0x21, 0xb4, 0x1d, 0x6f, 0xea, 0x46, 0x36, 0x95, 0x93, 0x16,
+	0xab, 0xdf, 0x92, 0x4c, 0x31, 0xf1, 0x5d, 0x68, 0x89, 0x36, 0x52, 0xb9, 0xcf, 0x01, 0xd0, 0x1b,
+	0xf1, 0x93, 0x81, 0x2d, 0xd0, 0xea, 0x6e, 0xa5, 0xad, 0x0e, 0x1d, 0x13, 0x9e, 0x10, 0xa3, 0x11,
+	0xca, 0xaf, 0x50, 0x7f, 0x00, 0xed, 0x3e, 0x75, 0x29, 0xa3, 0xeb, 0x75, 0x7d, 0x0c, 0x1d, 0x05,
+	0x91, 0x23, 0x69, 0x50, 0xb3, 0x39, 0xc7, 0x96, 0x9e, 0x53, 0x91, 0xfa, 0x7f, 0x15, 0xa0, 0xae,
+	0x86, 0x59, 0xed, 0x8a, 0x7c, 0x08, 0x65, 0x7e, 0x6e, 0x85, 0xd7, 0xee, 0x66, 0x4f, 0x10, 0xa5,
+	0x06, 0x97, 0xca, 0x55, 0x2e, 0xc5, 0xab, 0xfc, 0x09, 0x54, 0x42, 0x66, 0x49, 0xfb, 0x68, 0x6e,
+	0x6f, 0xac, 0x38, 0xfb, 0x31, 0x4a, 0x0d, 0x01, 0x22, 0x1f, 0x43, 0x45, 0x19, 0x4a, 0x69, 0x79,
+	0x10, 0x5c, 0x38, 0x43, 0x88, 0xc9, 0x53, 0xa8, 0xa1, 0x3f, 0x58, 0x50, 0x5b, 0xab, 0x6e, 0x96,
+	0x96, 0xfb, 0x1d, 0x0b, 0xd1, 0x91, 0x31, 0x32, 0x14, 0x4c, 0xff, 0x02, 0x20, 0x61, 0xe7, 0xcc,
+	0x8e, 0x7b, 0x75, 0x2b, 0xf4, 0x3d, 0x79, 0x2e, 0x25, 0xa5, 0x07, 0x00, 0x89, 0x9a, 0xe4, 0x96,
+	0xd2, 0x4f, 0x2c, 0x9d, 0xd4, 0xe6, 0x3d, 0x68, 0x44, 0xde, 0x74, 0x66, 0x79, 0xa7, 0xd2, 0x98,
+	0x2a, 0x46, 0xc2, 0xc0, 0x9e, 0x4f, 0x2c, 0xc7, 0xa5, 0x62, 0x55, 0x2a, 0x86, 0xa4, 0x70, 0x23,
+	0xd4, 0x1c, 0xc4, 0xd9, 0x89, 0x75, 0x3d, 0x86, 0x32, 0xdf, 0x83, 0x3c, 0x0f, 0xf2, 0x09, 0xd4,
+	0xa7, 0x33, 0xc7, 0xb5, 0x03, 0x8a, 0x9a, 0x96, 0x72, 0x77, 0x22, 0x46, 0xe0, 0x18, 0x9e, 0xef,
+	0x78, 0x36, 0x3d, 0x97, 0x77, 0x9c, 0x22, 0xf5, 0x7f, 0x29, 0xc0, 0xed, 0xdd, 0x80, 0x5a, 0x8c,
+	0x8e, 0xa7, 0x33, 0x6a, 0x47, 0xee, 0x7a, 0x23, 0x42, 0x3d, 0xa6, 0x41, 0xbc, 0x32, 0xfc, 0x9b,
+	0xfc, 0x21, 0xd4, 0x1d, 0x8f, 0xd1, 0xe0, 0x8d, 0xe5, 0x6a, 0x25, 0x79, 0x99, 0x89, 0xe0, 0x60,
+	0x4b, 0x05, 0x07, 0x5b, 0x7d, 0x19, 0x3c, 0x18, 0x31, 0x34, 0x7d, 0xfb, 0x97, 0xdf, 0xf5, 0xf6,
+	0xff, 0x16, 0x36, 0x96, 0x35, 0x95, 0xb6, 0xfc, 0x14, 0xea, 0xa1, 0xe4, 0x71, 0x7d, 0x97, 0xcf,
+	0x8c, 0xc2, 0xc7, 0x28, 0x7d, 0x03, 0x6e, 0xe1, 0xb9, 0x53, 0x92, 0x50, 0x4e, 0x5a, 0xff, 0x0e,
+	0x6e, 0x2f, 0xf1, 0xe5, 0x10, 0xdb, 0xd0, 0x50, 0x8d, 0xf3, 0xcf, 0xa5, 0x1a, 0x23, 0x81, 0xe9,
+	0x3f, 0x83, 0xdb, 0xe2, 0xd0, 0x2d, 0x2f, 0xed, 0x92, 0x0b, 0xd2, 0x35, 0xd8, 0x58, 0x06, 0x4a,
+	0x9f, 0xf2, 0xcf, 0x45, 0xa8, 0x2b, 0xe6, 0x72, 0x33, 0xb5, 0x43, 0xc5, 0xd5, 0x1d, 0x2a, 0xad,
+	0xd9, 0xa1, 0xf2, 0xef, 0xb5, 0x43, 0x95, 0x77, 0xdc, 0x21, 0x3c, 0x16, 0xfe, 0x99, 0x47, 0x45,
+	0x58, 0xd3, 0x30, 0x04, 0x81, 0x0a, 0x78, 0xf4, 0x9c, 0x99, 0x41, 0xe4, 0xf1, 0xb0, 0x05, 0xef,
+	0xd1, 0x65, 0x05, 0x26, 0x2a, 0x7e, 0x34, 0x6a, 0x88, 0x35, 0x22, 0x8f, 0x7c, 0x02, 0xe5, 0x20,
+	0xf2, 0x30, 0x7c, 0x29, 0x2d, 0x8f, 0xae, 0x56, 0xc4, 0x36, 0x22, 0xcf, 0xe0, 0x28, 0xfd, 0xaf,
+	0x0b, 0xd0, 0x4a, 0xb3, 0xc9, 0x16, 0x94, 0x31, 0x28, 0xd5, 0x0a, 0x6f, 0x1d, 0x91, 0xe3, 0xc8,
+	0x6d, 0xa8, 0xbe, 0xf2, 0x8f, 0x4d, 0xc7, 0x96, 0xeb, 0x59, 0x79, 0xe5, 0x1f, 0x0f, 0x33, 0xa7,
+	0x53, 0x9e, 0x1c, 0x49, 0xe2, 0x64, 0x29, 0x8f, 0xe1, 0xc4, 0x1d, 0x2c, 0x08, 0x7d, 0x0f, 0x9a,
+	0x7d, 0xe7, 0xe4, 0x64, 0xfd, 0x21, 0xba, 0x03, 0xb5, 0x93, 0xc0, 0x9f, 0x27, 0x03, 0x55, 0x91,
+	0x1c, 0xda, 0xe4, 0x26, 0x54, 0x98, 0x6f, 0xc6, 0x4e, 0xb3, 0xcc, 0xfc, 0xa1, 0xad, 0xff, 0x7d,
+	0x01, 0x5a, 0xa2, 0x3f, 0x69, 0x87, 0xa9, 0xe6, 0x85, 0xfc, 0xe6, 0xc5, 0xa4, 0x39, 0x79, 0x24,
+	0x7d, 0x75, 0x69, 0xf5, 0x50, 0x60, 0xaf, 0x29, 0x7f, 0xfd, 0x14, 0x6a, 0xc2, 0x51, 0xe1, 0x81,
+	0x5c, 0xf1, 0xa4, 0xe8, 0x73, 0x77, 0xb9, 0xd8, 0x50, 0x30, 0xfd, 0x77, 0x05, 0xa8, 0xab, 0x4e,
+	0x72, 0x5d, 0xd4, 0x16, 0x54, 0x05, 0x96, 0xab, 0xd4, 0x59, 0xf2, 0xf9, 0x5c, 0x32, 0xb9, 0x58,
+	0x50, 0x43, 0xa2, 0xf0, 0x14, 0xc7, 0x2e, 0xad, 0xb4, 0x7a, 0xc2, 0x62, 0x85, 0x63, 0x94, 0xfe,
+	0xef, 0x05, 0x80, 0x44, 0x35, 0x54, 0x62, 0x61, 0xb1, 0x99, 0x52, 0x02, 0xbf, 0xaf, 0xac, 0xc4,
+	0x87, 0x50, 0xc6, 0x05, 0x95, 0x2b, 0xb6, 0x7a, 0xf1, 0x70, 0x29, 0xd9, 0x84, 0x22, 0xf3, 0xb5,
+	0xf2, 0x1a, 0x4c, 0x91, 0xf9, 0xdc, 0xdb, 0x3b, 0xd4, 0xb5, 0xc5, 0x15, 0xd6, 0x30, 0x24, 0xa5,
+	0xff, 0x53, 0x15, 0xca, 0x08, 0xca, 0xbf, 0x7a, 0xf0, 0xf6, 0x8b, 0x42, 0x79, 0x77, 0x48, 0x2a,
+	0x31, 0xb4, 0x52, 0xca, 0xd0, 0x78, 0x42, 0xe0, 0x7b, 0x8c, 0x7a, 0xcc, 0x64, 0x17, 0x0b, 0x2a,
+	0xad, 0xb0, 0x29, 0x79, 0x38, 0x27, 0x6c, 0xc8, 0x1c, 0xe6, 0x52, 0x99, 0xa0, 0x08, 0x22, 0xdd,
+	0x70, 0x66, 0x85, 0x33, 0xad, 0x9a, 0x69, 0xf8, 0xc2, 0x0a, 0x67, 0xd8, 0x50, 0xa4, 0x0e, 0x35,
+	0xae, 0xbb, 0x20, 0xc8, 0x2f, 0x01, 0x4e, 0x28, 0xc3, 0x33, 0x66, 0x5a, 0x4c, 0xab, 0xbf, 0xf5,
+	0x5c, 0x35, 0x24, 0x7a, 0x87, 0xe1, 0xce, 0x50, 0x66, 0x9d, 0xf2, 0x9c, 0xa2, 0x61, 0xf0, 0x6f,
+	0x8c, 0x8f, 0x5c, 0x2b, 0x64, 0xe6, 0xdc, 0xb7, 0x9d, 0x13, 0x87, 0xda, 0x3c, 0x8d, 0x68, 0x18,
+	0x2d, 0x64, 0xee, 0x49, 0x1e, 0x2a, 0xeb, 0xf9, 0x29, 0x8c, 0x48, 0x16, 0x9a, 0x9e, 0x9f, 0x40,
+	0x08, 0x94, 0x79, 0xec, 0xd7, 0xe2, 0xb1, 0x1f, 0xff, 0xc6, 0x2c, 0x63, 0x29, 0x09, 0x6a, 0xf3,
+	0x99, 0xb4, 0x33, 0xd9, 0x0c, 0xd9, 0x84, 0xa6, 0x4d, 0xc3, 0x69, 0xe0, 0x70, 0xff, 0xc5, 0x73,
+	0x82, 0x86, 0x91, 0x66, 0xe1, 0x2e, 0xcd, 0x3e, 0x13, 0xe1, 0x7f, 0xc3, 0xc0, 0x4f, 0xbc, 0xe4,
+	0xe3, 0x54, 0x45, 0xeb, 0xf2, 0x16, 0x09, 0x23, 0x7d, 0xd1, 0xde, 0xc8, 0x5c, 0xb4, 0xb8, 0xa6,
+	0x67, 0x7e, 0x60, 0x87, 0x1a, 0x11, 0x21, 0x03, 0x27, 0x30, 0x3a, 0x8f, 0xd3, 0xa8, 0x9b, 0x22,
+	0x3a, 0x4f, 0xa7, 0x4a, 0xea, 0x5b, 0x4e, 0xe2, 0x96, 0x98, 0x84, 0xe2, 0x8a, 0x49, 0x6c, 0x43,
+	0x63, 0x16, 0xd0, 0x13, 0xd7, 0xf2, 0x4e, 0x43, 0xed, 0xf6, 0xea, 0xb9, 0x79, 0x21, 0x85, 0x46,
+	0x02, 0xc3, 0x60, 0x9c, 0xd1, 0x73, 0x69, 0x00, 0x1b, 0x22, 0x01, 0x41, 0x06, 0xdf, 0x7d, 0x74,
+	0x79, 0xce, 0x9c, 0x8b, 0xee, 0x6c, 0x16, 0x1e, 0x55, 0x0d, 0x45, 0xe2, 0x6e, 0x24, 0xc9, 0x96,
+	0x7f, 0xa2, 0x69, 0x72, 0xc1, 0x14, 0xef, 0xe0, 0x84, 0xec, 0xc2, 0xf5, 0x90, 0x05, 0xd1, 0x94,
+	0x45, 0x01, 0xb5, 0x4d, 0xdb, 0x62, 0x96, 0x76, 0x57, 0x5a, 0x4a, 0xda, 0x81, 0xc7, 0x90, 0xbe,
+	0xc5, 0x2c, 0xa3, 0x13, 0x66, 0x68, 0xfd, 0x7f, 0x0a, 0xd0, 0xc9, 0x42, 0xc8, 0xcf, 0xa1, 0xf6,
+	0x2a, 0xf4, 0x3d, 0xd3, 0xb5, 0xe5, 0xed, 0x4b, 0xd2, 0xfd, 0x7d, 0x1b, 0xfa, 0xde, 0xc8, 0x36,
+	0xaa, 0xaf, 0xf8, 0x2f, 0xf9, 0x12, 0x1a, 0x73, 0x67, 0x1a, 0xf8, 0x7c, 0x78, 0x11, 0x1d, 0x2d,
+	0xa5, 0xd8, 0x52, 0x38, 0x64, 0x74, 0x6e, 0x24, 0x58, 0xf2, 0x25, 0x80, 0xbf, 0xa0, 0x9e, 0x79,
+	0x1a, 0x58, 0x8b, 0x99, 0x56, 0x5a, 0xbd, 0x79, 0xf6, 0x28, 0xb3, 0x0e, 0x03, 0x7f, 0x41, 0x03,
+	0x76, 0x61, 0x34, 0x10, 0xfb, 0x1c, 0xa1, 0x78, 0x5b, 0xb2, 0x33, 0x07, 0x33, 0x30, 0xad, 0xfc,
+	0x96, 0x56, 0x0a, 0xa8, 0x3f, 0x83, 0xaa, 0xd0, 0x1b, 0xad, 0x2c, 0xb0, 0xce, 0x94, 0x2f, 0x08,
+	0xac, 0xb3, 0xe4, 0xcc, 0x17, 0xd3, 0x67, 0x1e, 0x0f, 0xf4, 0xc5, 0x82, 0x86, 0x5c, 0xb3, 0x86,
+	0x21, 0x08, 0x9d, 0x61, 0xf2, 0x94, 0x9a, 0x50, 0x02, 0x2b, 0xa4, 0x60, 0x32, 0x7a, 0x28, 0xc6,
+	0xd1, 0xc3, 0xd7, 0x00, 0x0b, 0xa1, 0x93, 0x23, 0x7b, 0x6c, 0x6e, 0xbf, 0x9f, 0xbb, 0x4a, 0xb1,
+	0xea, 0xa9, 0x06, 0xba, 0x0b, 0x37, 0x56, 0x00, 0xb9, 0xd7, 0xc0, 0x2d, 0xa8, 0xbc, 0xb1, 0xdc,
+	0x88, 0xaa, 0xa9, 0x70, 0x82, 0x7c, 0x0a, 0x65, 0x2c, 0xb1, 0xc4, 0x31, 0xe3, 0xda, 0xdd, 0xe1,
+	0x30, 0xfd, 0x8f, 0xa1, 0xb5, 0x47, 0xdf, 0x32, 0x90, 0x06, 0x35, 0xe9, 0xc4, 0xe4, 0x50, 0x8a,
+	0xd4, 0x9f, 0x42, 0x5d, 0x9d, 0x02, 0x6c, 0x89, 0xbf, 0xaa, 0x25, 0xe7, 0xad, 0x04, 0x52, 0xfa,
+	0x57, 0x70, 0x4b, 0x96, 0x80, 0x0c, 0xba, 0xf0, 0x2f, 0xab, 0x63, 0x2d, 0x2d, 0xab, 0xfe, 0xb7,
+	0x05, 0xb8, 0xbd, 0xd4, 0x74, 0x4d, 0xe2, 0xf9, 0x00, 0x5a, 0xb2, 0xf2, 0x64, 0x46, 0x81, 0xab,
+	0xbc, 0x7e, 0x53, 0xf2, 0x8e, 0x02, 0x37, 0x4c, 0x43, 0x7c, 0xcf, 0xbd, 0x90, 0xfb, 0xae, 0x20,
+	0x07, 0x9e, 0x7b, 0x41, 0xde, 0x07, 0x10, 0xa5, 0x1b, 0x0e, 0x28, 0x73, 0x40, 0x83, 0x73, 0x50,
+	0xac, 0xff, 0x5b, 0x01, 0xda, 0x83, 0xf3, 0x2b, 0x4d, 0x81, 0x3c, 0x85, 0xea, 0x89, 0x1f, 0xcc,
+	0x2d, 0xc6, 0x77, 0xa7, 0x93, 0xb5, 0x65, 0xd1, 0xd9, 0x33, 0x2e, 0x37, 0x24, 0x8e, 0xdc, 0x85,
+	0xfa, 0xb1, 0x15, 0x52, 0x9c, 0x87, 0xbc, 0x88, 0x6a, 0x48, 0x1f, 0x05, 0x2e, 0xd9, 0x82, 0x8a,
+	0x38, 0x4d, 0x39, 0x51, 0x24, 0x3f, 0x3b, 0x71, 0xc9, 0x85, 0xc3, 0xf4, 0xbf, 0x2b, 0x40, 0x2b,
+	0xcd, 0xcf, 0x16, 0x29, 0x0a, 0x4b, 0x45, 0x8a, 0x9f, 0xc3, 0x8d, 0xa9, 0xef, 0xba, 0xd6, 0x82,
+	0x97, 0xf1, 0x8e, 0x5d, 0x07, 0x9d, 0xa0, 0x58, 0xc8, 0xae, 0x12, 0x8c, 0x25, 0x9f, 0x7c, 0x0c,
+	0xd7, 0xa7, 0xbe, 0xeb, 0x07, 0xe6, 0xf1, 0x85, 0x29, 0x6f, 0xda, 0x92, 0x2c, 0x3e, 0x21, 0xfb,
+	0x9b, 0x8b, 0x71, 0x7c, 0xe1, 0x0a, 0x7f, 0x2b, 0x2a, 0x7e, 0x82, 0xd0, 0x0d, 0xe8, 0x88, 0xb9,
+	0xaf, 0xdd, 0xd0, 0xc7, 0x50, 0xc6, 0x52, 0x84, 0xb4, 0xe9, 0x8d, 0x9c, 0x55, 0x73, 0x5c, 0x8c,
+	0x20, 0x1c, 0x97, 0x7e, 0x5b, 0xae, 0x17, 0xbb, 0x25, 0xfd, 0x57, 0x00, 0x89, 0xe4, 0x5d, 0x8c,
+	0xba, 0x95, 0x18, 0xf5, 0x17, 0x40, 0x78, 0xe1, 0xef, 0xaa, 0x06, 0xfa, 0x0f, 0x05, 0xb8, 0x99,
+	0x69, 0xb8, 0x66, 0x36, 0x38, 0x32, 0x96, 0x0c, 0xe3, 0xc2, 0x88, 0x22, 0x31, 0xa6, 0x92, 0x55,
+	0xc8, 0xd2, 0x6a, 0xa8, 0xc8, 0xbb, 0x3e, 0x0a, 0x31, 0x0e, 0x92, 0x28, 0xcc, 0xfd, 0x45, 0xb6,
+	0xbc, 0x26, 0xb2, 0x7c, 0x49, 0x9d, 0xd3, 0x19, 0x93, 0x59, 0xb4, 0xfe, 0xbf, 0x05, 0x80, 0xa4,
+	0x93, 0xfc, 0x34, 0x94, 0x47, 0x3c, 0x2a, 0xd0, 0xc5, 0x50, 0xe7, 0x3e, 0x34, 0xd9, 0xcc, 0x09,
+	0x6c, 0x73, 0x61, 0x05, 0xec, 0x42, 0x6e, 0x2b, 0x70, 0xd6, 0x21, 0x72, 0x92, 0x8c, 0xbd, 0x9c,
+	0xce, 0xd8, 0x93, 0x90, 0xab, 0x92, 0x1f, 0x72, 0x55, 0x2f, 0x0b, 0xb9, 0x6a, 0xab, 0x21, 0x97,
+	0x0a, 0x46, 0xea, 0xa9, 0x60, 0x64, 0x03, 0xaa, 0xc7, 0x81, 0xff, 0x9a, 0x7a, 0x3c, 0xfc, 0xa9,
+	0x1b, 0x92, 0xd2, 0xff, 0x53, 0x46, 0xaf, 0x62, 0xfa, 0x39, 0x13, 0xbd, 0x07, 0x8d, 0x19, 0x9b,
+	0xbb, 0xe9, 0xd2, 0x56, 0x1d, 0x19, 0xbc, 0xf6, 0xf5, 0x3e, 0x00, 0x5f, 0x5e, 0x21, 0x2d, 0x71,
+	0x69, 0x83, 0x73, 0xb8, 0xf8, 0x21, 0xb4, 0x23, 0xef, 0xb5, 0xe7, 0x9f, 0x79, 0x1c, 0xa0, 0xe6,
+	0xdd, 0x92, 0x4c, 0xc4, 0x84, 0xd8, 0x47, 0xaa, 0xc2, 0x56, 0x11, 0x7d, 0xb0, 0xb8, 0xbc, 0xb6,
+	0x11, 0xef, 0x73, 0x55, 0xac, 0x8e, 0xa0, 0xf4, 0xaf, 0xe1, 0x6e, 0xba, 0x8e, 0x7c, 0x55, 0x03,
+	0xfc, 0xd7, 0x02, 0xf4, 0xf2, 0xda, 0x5f, 0xd9, 0x0e, 0x7f, 0x01, 0x35, 0xdb, 0x9f, 0x5b, 0x8e,
+	0xa7, 0x0c, 0x71, 0xb9, 0x3e, 0xcb, 0x87, 0xe8, 0x73, 0x88, 0xa1, 0xa0, 0xe8, 0x90, 0xd4, 0xe9,
+	0x5e, 0xb9, 0xa8, 0x33, 0x6a, 0xc9, 0x73, 0xef, 0x42, 0x47, 0xb1, 0x45, 0x57, 0xb8, 0x2e, 0xa2,
+	0x33, 0x95, 0x82, 0x09, 0x2a, 0xf1, 0x1b, 0xc2, 0x01, 0x09, 0x22, 0xb1, 0xbc, 0xd2, 0x92, 0xe5,
+	0x49, 0xa3, 0x10, 0x1b, 0xa3, 0x8c, 0x02, 0xdd, 0x5f, 0x5a, 0x8b, 0x9c, 0xf5, 0x8c, 0x3b, 0x2c,
+	0x8a, 0xeb, 0x5d, 0x74, 0x98, 0x5a, 0xa6, 0x52, 0x76, 0x99, 0x12, 0x23, 0x2f, 0xe7, 0x1b, 0x79,
+	0x25, 0x6d, 0xe4, 0x89, 0x62, 0xd5, 0x8c, 0xb5, 0x86, 0xd0, 0xda, 0x89, 0x6c, 0xe7, 0x0a, 0xd7,
+	0x88, 0xac, 0xe1, 0x62, 0xb9, 0xda, 0xa5, 0xde, 0x29, 0x9b, 0xc9, 0x15, 0xc0, 0x1a, 0xee, 0x51,
+	0xe0, 0x8e, 0x38, 0x8f, 0xbb, 0x77, 0xc7, 0x33, 0x45, 0x6c, 0x5c, 0x96, 0xee, 0xdd, 0xf1, 0x5e,
+	0x22, 0xad, 0x53, 0x68, 0xcb, 0x41, 0xd7, 0x18, 0x47, 0x6a, 0x2d, 0x52, 0x8b, 0xbb, 0x05, 0x55,
+	0x27, 0x0c, 0x23, 0x9a, 0xef, 0xa0, 0xb0, 0xc3, 0x21, 0x8a, 0x0d, 0x89, 0xd2, 0x5d, 0x80, 0x84,
+	0xcb, 0x2b, 0x07, 0x78, 0xbc, 0x0b, 0xab, 0xcf, 0x1a, 0x09, 0x8a, 0x27, 0x8d, 0x1c, 0x87, 0x06,
+	0x95, 0xec, 0xc7, 0x92, 0x41, 0xf1, 0x06, 0xcf, 0x1c, 0xcf, 0x76, 0xbc, 0x53, 0xe5, 0xe0, 0xbe,
+	0x82, 0x56, 0x9a, 0x9d, 0x9f, 0x09, 0xda, 0x94, 0x59, 0x8e, 0x0a, 0x49, 0x24, 0xa5, 0xff, 0x0a,
+	0x36, 0x76, 0x55, 0xaa, 0x71, 0xd5, 0x53, 0xf7, 0xdf, 0x05, 0xb8, 0xb3, 0xd2, 0x78, 0xcd, 0xaa,
+	0x6e, 0xf3, 0xa4, 0xd9, 0xf1, 0xd4, 0x94, 0x32, 0x6b, 0x10, 0x77, 0xb2, 0x8b, 0x10, 0x43, 0x22,
+	0xc9, 0xe7, 0xb1, 0xdd, 0x88, 0x35, 0xbf, 0x97, 0x6e, 0xf3, 0x0d, 0x97, 0x24, 0xc3, 0x4b, 0x28,
+	0x79, 0x01, 0x37, 0x54, 0x52, 0x62, 0x4e, 0xdd, 0x28, 0x64, 0x34, 0x50, 0xe7, 0xf2, 0x5e, 0x5e,
+	0x0e, 0xb3, 0x2b, 0x30, 0x46, 0x77, 0x96, 0x65, 0xe0, 0xa2, 0x76, 0xb2, 0x8a, 0xa1, 0x2b, 0xe6,
+	0x61, 0x95, 0x08, 0x82, 0xf9, 0x37, 0xf2, 0x5c, 0xdf, 0x5f, 0x48, 0x47, 0xc2, 0xbf, 0xf5, 0xbf,
+	0x29, 0xc0, 0xf5, 0x25, 0xfd, 0x72, 0x96, 0x33, 0x93, 0xf6, 0x15, 0x73, 0xd2, 0x3e, 0xa1, 0x6d,
+	0x72, 0xf8, 0x04, 0x79, 0xb5, 0xc3, 0xa7, 0xff, 0x04, 0xd7, 0x97, 0xa6, 0x9a, 0x58, 0x57, 0x61,
+	0xd5, 0xba, 0x14, 0x36, 0x5d, 0x12, 0xff, 0x12, 0x6a, 0x73, 0x27, 0x0c, 0x1d, 0xef, 0x54, 0x2b,
+	0xe6, 0xc5, 0xf4, 0x5c, 0x64, 0x50, 0x16, 0x05, 0x1e, 0xf7, 0x72, 0x0a, 0xad, 0x1f, 0x42, 0x2b,
+	0xdd, 0x5f, 0xbe, 0xe3, 0x11, 0x59, 0xa6, 0x74, 0x3c, 0x9c, 0x58, 0x3f, 0x77, 0xfd, 0x3b, 0x4c,
+	0x11, 0x96, 0xc6, 0xc3, 0x2d, 0xe0, 0x05, 0x16, 0x19, 0xe4, 0xe0, 0x37, 0xda, 0x1f, 0xf3, 0x95,
+	0xad, 0x32, 0x3f, 0x8e, 0xd1, 0x4b, 0x49, 0x8c, 0xae, 0xff, 0x16, 0x36, 0xe2, 0x67, 0xbf, 0x2b,
+	0xda, 0x3e, 0x5e, 0xdc, 0x3c, 0x84, 0x54, 0xcf, 0x83, 0xc2, 0x0f, 0xe1, 0x33, 0x91, 0x7a, 0x18,
+	0xd4, 0x2f, 0xe0, 0xce, 0x4a, 0xf7, 0x57, 0xf2, 0x39, 0x5f, 0x41, 0x3d, 0xb6, 0x60, 0x71, 0x02,
+	0xde, 0xcb, 0x54, 0xaf, 0x54, 0xe7, 0xca, 0x84, 0x63, 0xb4, 0xfe, 0x03, 0x74, 0x97, 0xa5, 0xdc,
+	0x3c, 0xce, 0xad, 0x29, 0x93, 0x8f, 0x46, 0x82, 0x20, 0x4f, 0xb2, 0x9e, 0xe6, 0x6e, 0xee, 0x00,
+	0x29, 0x63, 0xd0, 0x5f, 0x42, 0x3b, 0xc3, 0xcf, 0x59, 0x2b, 0xf1, 0x3e, 0x28, 0xd6, 0x45, 0x3e,
+	0x01, 0x2a, 0x7a, 0x7d, 0xf1, 0x53, 0xdf, 0x83, 0xdb, 0x4b, 0x39, 0xfc, 0x3b, 0x6f, 0x86, 0x0a,
+	0xdf, 0x4a, 0x49, 0xf8, 0xa6, 0xff, 0x05, 0x6c, 0x2c, 0x77, 0xb7, 0x66, 0xf1, 0x7f, 0x91, 0x5d,
+	0x82, 0x0f, 0x96, 0x63, 0xc9, 0xa5, 0x6e, 0xe4, 0x3a, 0x7c, 0x0f, 0x64, 0x55, 0x98, 0xa3, 0xeb,
+	0x16, 0x94, 0x65, 0xcd, 0xe0, 0x6d, 0x25, 0x0b, 0x8e, 0xd3, 0xb7, 0xa1, 0x63, 0xd0, 0x30, 0x72,
+	0x59, 0xf8, 0xee, 0x8e, 0x78, 0x00, 0x55, 0x83, 0x4e, 0xfd, 0xc0, 0xce, 0xc1, 0xfe, 0x41, 0x5c,
+	0x35, 0x14, 0xd3, 0xbb, 0x91, 0xd6, 0xe0, 0x19, 0x4a, 0xe2, 0x42, 0xe2, 0xe7, 0x50, 0xe1, 0x8c,
+	0xdc, 0xac, 0x61, 0x03, 0xaa, 0x3c, 0xcd, 0x56, 0x47, 0x55, 0x52, 0xfa, 0x6f, 0xa1, 0x3d, 0xa6,
+	0xf8, 0x07, 0x86, 0x77, 0xdf, 0xae, 0x5b, 0x50, 0xe1, 0x2f, 0xbc, 0xaa, 0xfa, 0xc8, 0x09, 0x11,
+	0xea, 0xcc, 0x1d, 0xa6, 0xc2, 0x69, 0x4e, 0xe8, 0xaf, 0xa0, 0xa3, 0xba, 0x5f, 0x7f, 0x76, 0x78,
+	0x7c, 0xa9, 0xce, 0x0e, 0x27, 0xb0, 0x7a, 0x12, 0x88, 0x65, 0xcc, 0xab, 0xb9, 0xc4, 0x5d, 0x46,
+	0x2e, 0x33, 0x14, 0x50, 0xff, 0x2b, 0x2c, 0xf8, 0xa7, 0x24, 0xf9, 0xfe, 0x4a, 0xd4, 0x3f, 0x8b,
+	0xe9, 0xfa, 0x27, 0x1a, 0xb5, 0x87, 0x56, 0xcc, 0xe4, 0x94, 0x14, 0x89, 0xf8, 0x70, 0xea, 0x07,
+	0x54, 0x4d, 0x8a, 0x13, 0xbc, 0x56, 0x16, 0x50, 0x7c, 0xc4, 0x65, 0x33, 0x59, 0xcc, 0xad, 0x23,
+	0xe3, 0xd0, 0x62, 0xb3, 0xc7, 0xdf, 0x41, 0x27, 0xfb, 0x57, 0x07, 0x72, 0x13, 0xae, 0xef, 0x1f,
+	0x3c, 0x3b, 0x18, 0x8d, 0x0e, 0x5e, 0x9a, 0xc3, 0xe7, 0xfb, 0x07, 0xc6, 0xa0, 0x7b, 0x8d, 0x10,
+	0xe8, 0xc4, 0xcc, 0xc3, 0x9d, 0xe7, 0x83, 0x71, 0xb7, 0x40, 0xba, 0xd0, 0x8a, 0x79, 0x3b, 0xa3,
+	0x51, 0xb7, 0xf8, 0xf8, 0x4f, 0x00, 0x92, 0x8a, 0x34, 0x69, 0x43, 0xe3, 0x68, 0x7f, 0xf7, 0xc5,
+	0xce, 0xfe, 0xf3, 0x41, 0xbf, 0x7b, 0x8d, 0x34, 0xa0, 0xb2, 0xd3, 0xef, 0x0f, 0xfa, 0xdd, 0x02,
+	0x69, 0x42, 0xcd, 0x18, 0xec, 0x1d, 0x7c, 0x3f, 0xe8, 0x77, 0x8b, 0x48, 0x28, 0x50, 0xe9, 0xf1,
+	0x0c, 0x83, 0xc7, 0x24, 0x3f, 0x27, 0xef, 0xc3, 0xdd, 0xc1, 0x6f, 0x0e, 0x0f, 0x8c, 0x89, 0xf9,
+	0xec, 0xc0, 0xd8, 0xdb, 0x99, 0x98, 0x47, 0xfb, 0xe3, 0xc3, 0xc1, 0xee, 0xf0, 0xd9, 0x90, 0xf7,
+	0xd9, 0x84, 0xda, 0x78, 0x38, 0x19, 0xec, 0xed, 0x1c, 0x76, 0x0b, 0xa4, 0x06, 0xa5, 0xfe, 0xc1,
+	0x44, 0xf4, 0xf8, 0xdc, 0xd8, 0x39, 0x7c, 0xb1, 0x37, 0xea, 0x96, 0x90, 0xd8, 0x1b, 0x18, 0x7b,
+	0x3b, 0xc3, 0x7e, 0xb7, 0x8c, 0x3a, 0x7c, 0x3b, 0x3e, 0xd8, 0x1f, 0x75, 0x2b, 0x8f, 0xff, 0xaf,
+	0x00, 0x9d, 0x6c, 0x34, 0x44, 0xee, 0xc1, 0x9d, 0x9d, 0xa3, 0xfe, 0x70, 0x62, 0x0e, 0xc7, 0xe3,
+	0xa3, 0xc1, 0xd2, 0x50, 0x37, 0xa0, 0xbd, 0x37, 0x1c, 0x8f, 0x87, 0xfb, 0xcf, 0xcd, 0xc9, 0x70,
+	0x32, 0x1a, 0x74, 0x0b, 0xb8, 0x52, 0xfd, 0xa3, 0xc3, 0xd1, 0x70, 0x77, 0x67, 0x32, 0x90, 0xcc,
+	0x22, 0xb9, 0x03, 0x37, 0x15, 0xae, 0x3f, 0x18, 0xef, 0x1a, 0xc3, 0xc3, 0xc9, 0xf0, 0x60, 0xbf,
+	0x5b, 0x22, 0x77, 0xe1, 0x76, 0x82, 0x4e, 0x8b, 0xca, 0xa4, 0x03, 0xa0, 0xda, 0xbc, 0xf8, 0xac,
+	0x5b, 0x21, 0xd7, 0xa1, 0xb9, 0x77, 0x34, 0x9a, 0x0c, 0x0f, 0x47, 0x03, 0x64, 0x54, 0xb1, 0xd3,
+	0xdd, 0x9d, 0xfd, 0x83, 0xfd, 0xe1, 0xee, 0xce, 0xc8, 0x1c, 0x8c, 0xc6, 0x83, 0x97, 0x2f, 0x06,
+	0xc6, 0xa0, 0x5b, 0xc3, 0xd9, 0xed, 0x1f, 0x0c, 0xf7, 0xfb, 0x83, 0xdf, 0x74, 0xeb, 0xa4, 0x05,
+	0xf5, 0xd1, 0xc1, 0xfe, 0x73, 0xf3, 0xc8, 0x18, 0x75, 0x1b, 0xb8, 0x3d, 0x93, 0x17, 0xc3, 0x7d,
+	0x73, 0xf7, 0x60, 0x7f, 0x32, 0xd8, 0x9f, 0x74, 0x61, 0xfb, 0x77, 0xb8, 0xd4, 0xc2, 0x2c, 0xc9,
+	0xaf, 0xa1, 0xc2, 0xff, 0x55, 0x40, 0xb2, 0x96, 0x9a, 0xfa, 0xcb, 0x53, 0xef, 0x6e, 0x8e, 0x44,
+	0xbe, 0xea, 0x5d, 0x23, 0x7f, 0x04, 0x65, 0xfc, 0xef, 0x00, 0xb9, 0x93, 0x05, 0xc5, 0x7f, 0x37,
+	0xe8, 0x69, 0xab, 0x82, 0x74, 0x63, 0x7c, 0xa4, 0xcc, 0x36, 0x4e, 0xfd, 0xf5, 0xa0, 0xa7, 0xad,
+	0x0a, 0xe2, 0xc6, 0x3b, 0x50, 0x15, 0x6f, 0x8d, 0x24, 0x7b, 0x7d, 0xa4, 0xff, 0x40, 0xd0, 0xeb,
+	0xe5, 0x89, 0xe2, 0x2e, 0x7e, 0x80, 0x4e, 0xf6, 0x21, 0x96, 0x3c, 0xc8, 0xbe, 0x0d, 0xe6, 0x3c,
+	0x27, 0xf7, 0xf4, 0xcb, 0x20, 0x71, 0xd7, 0xdf, 0x43, 0x3b, 0xf3, 0xfe, 0x4a, 0x36, 0x97, 0xa7,
+	0xb2, 0xfc, 0x64, 0xdb, 0x7b, 0x70, 0x09, 0x22, 0xad, 0x72, 0xf6, 0x85, 0x35, 0xab, 0x72, 0xee,
+	0x33, 0x6d, 0x4f, 0xbf, 0x0c, 0x92, 0xde, 0x0d, 0x7c, 0x9a, 0xca, 0xee, 0x46, 0xea, 0x0d, 0xb0,
+	0xa7, 0xad, 0x0a, 0xd2, 0xf3, 0xcd, 0x14, 0x0b, 0xb3, 0xf3, 0xcd, 0x2b, 0x41, 0xf6, 0x1e, 0x5c,
+	0x82, 0x88, 0xfb, 0xdd, 0x85, 0xaa, 0x70, 0x04, 0xd9, 0x5d, 0xce, 0x54, 0x02, 0x7b, 0xbd, 0x3c,
+	0x91, 0xea, 0xe2, 0x69, 0x81, 0x1c, 0x42, 0x33, 0x55, 0x28, 0x22, 0x1f, 0xac, 0x94, 0x79, 0xb2,
+	0x8a, 0xdd, 0x5f, 0x2b, 0x8f, 0xd5, 0xa2, 0x40, 0x56, 0x33, 0x7f, 0xf2, 0xd1, 0xda, 0x14, 0x3c,
+	0xd3, 0xff, 0xc7, 0x6f, 0x83, 0xc5, 0xc3, 0xfc, 0x1a, 0x2a, 0xdc, 0x37, 0x91, 0xd5, 0x5c, 0x2c,
+	0xf7, 0x74, 0x66, 0x72, 0x4c, 0xfd, 0x1a, 0xf9, 0x73, 0xb8, 0xbe, 0x94, 0x2a, 0x11, 0x3d, 0x37,
+	0x05, 0xca, 0x2a, 0xf8, 0xf0, 0x52, 0x4c, 0xba, 0xf7, 0xa5, 0x50, 0x33, 0xdb, 0x7b, 0x7e, 0x98,
+	0xdb, 0x7b, 0x78, 0x29, 0x26, 0x6d, 0xe9, 0x4b, 0x61, 0xce, 0x83, 0x4b, 0xc2, 0x98, 0x3c, 0x4b,
+	0xcf, 0x8f, 0xc4, 0xf4, 0x6b, 0xe4, 0x6b, 0xa8, 0xc9, 0x68, 0x87, 0x64, 0x4c, 0x27, 0x1b, 0x02,
+	0xf5, 0x48, 0x56, 0x86, 0xa1, 0x0e, 0x37, 0xa7, 0x1d, 0xa8, 0x8a, 0x0b, 0x3b, 0x6b, 0x93, 0x99,
+	0x80, 0xa4, 0xd7, 0xcb, 0x13, 0x29, 0x0d, 0xbe, 0xf9, 0xe8, 0xcf, 0x1e, 0x9e, 0x3a, 0x6c, 0x16,
+	0x1d, 0x6f, 0x4d, 0xfd, 0xf9, 0x93, 0xb3, 0x20, 0xf0, 0x9e, 0x48, 0xf8, 0x93, 0xc5, 0xeb, 0x53,
+	0xf5, 0x7d, 0x5c, 0xe5, 0xaf, 0x91, 0x9f, 0xff, 0xff, 0x00, 0x01, 0xe3, 0xf0, 0x99, 0xe2, 0x2a,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// CrawlerClient is the client API for Crawler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CrawlerClient interface {
+	// Start signals the service to start crawling the given URL.
+	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
+	// Stop signals the service to stop crawling the given URL. Stopping a crawl
+	// that has already finished succeeds without doing anything.
+	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
+	// Show the current site tree for all the given URLs.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Delete removes the stored site trees for the given URL.
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// CreateSchedule creates a schedule that starts crawling a URL at regular
+	// times.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// ListSchedules shows all of the schedules and their recent runs.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// DeleteSchedule removes a schedule. Crawls that it has already started are
+	// not stopped.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// Diff compares two crawls of the same URL and reports the pages that were
+	// added, removed and changed.
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error)
+	// SitemapReport compares the pages listed in a site's sitemaps with the
+	// pages that were reachable by following links. The crawl must have been
+	// started with use_sitemaps.
+	SitemapReport(ctx context.Context, in *SitemapReportRequest, opts ...grpc.CallOption) (*SitemapReportResponse, error)
+	// Export converts a crawl into another format, such as a sitemap. The files
+	// are streamed in chunks, so that large exports aren't limited by the size
+	// of a single message.
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Crawler_ExportClient, error)
+	// AssetReport summarizes the images, scripts, stylesheets and media used by
+	// the pages of a crawl.
+	AssetReport(ctx context.Context, in *AssetReportRequest, opts ...grpc.CallOption) (*AssetReportResponse, error)
+	// ExternalLinkReport summarizes the links from a crawl to other sites.
+	ExternalLinkReport(ctx context.Context, in *ExternalLinkReportRequest, opts ...grpc.CallOption) (*ExternalLinkReportResponse, error)
+	// Audit checks the pages of a crawl for common SEO problems, such as
+	// missing titles, duplicate descriptions and thin content.
+	Audit(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*AuditResponse, error)
+	// CanonicalReport reports the problems with the canonical and hreflang
+	// links of a crawl's pages.
+	CanonicalReport(ctx context.Context, in *CanonicalReportRequest, opts ...grpc.CallOption) (*CanonicalReportResponse, error)
+	// DuplicateReport groups the pages of a crawl that have the same or nearly
+	// the same text.
+	DuplicateReport(ctx context.Context, in *DuplicateReportRequest, opts ...grpc.CallOption) (*DuplicateReportResponse, error)
+	// StructuredData returns the JSON-LD, Microdata, OpenGraph and Twitter card
+	// metadata extracted from the pages of a crawl.
+	StructuredData(ctx context.Context, in *StructuredDataRequest, opts ...grpc.CallOption) (*StructuredDataResponse, error)
+	// Results streams the records extracted from the pages of a crawl by the
+	// crawl's extraction rules.
+	Results(ctx context.Context, in *ResultsRequest, opts ...grpc.CallOption) (Crawler_ResultsClient, error)
+	// Search finds the pages of a crawl whose visible text matches a query.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type crawlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCrawlerClient(cc grpc.ClientConnInterface) CrawlerClient {
+	return &crawlerClient{cc}
+}
+
+func (c *crawlerClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
+	out := new(StartResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
+	out := new(StopResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error) {
+	out := new(DiffResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) SitemapReport(ctx context.Context, in *SitemapReportRequest, opts ...grpc.CallOption) (*SitemapReportResponse, error) {
+	out := new(SitemapReportResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/SitemapReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Crawler_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Crawler_serviceDesc.Streams[0], "/crawler.v1.Crawler/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &crawlerExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Crawler_ExportClient interface {
+	Recv() (*ExportResponse, error)
+	grpc.ClientStream
+}
+
+type crawlerExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *crawlerExportClient) Recv() (*ExportResponse, error) {
+	m := new(ExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *crawlerClient) AssetReport(ctx context.Context, in *AssetReportRequest, opts ...grpc.CallOption) (*AssetReportResponse, error) {
+	out := new(AssetReportResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/AssetReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) ExternalLinkReport(ctx context.Context, in *ExternalLinkReportRequest, opts ...grpc.CallOption) (*ExternalLinkReportResponse, error) {
+	out := new(ExternalLinkReportResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/ExternalLinkReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) Audit(ctx context.Context, in *AuditRequest, opts ...grpc.CallOption) (*AuditResponse, error) {
+	out := new(AuditResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/Audit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) CanonicalReport(ctx context.Context, in *CanonicalReportRequest, opts ...grpc.CallOption) (*CanonicalReportResponse, error) {
+	out := new(CanonicalReportResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/CanonicalReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) DuplicateReport(ctx context.Context, in *DuplicateReportRequest, opts ...grpc.CallOption) (*DuplicateReportResponse, error) {
+	out := new(DuplicateReportResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/DuplicateReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) StructuredData(ctx context.Context, in *StructuredDataRequest, opts ...grpc.CallOption) (*StructuredDataResponse, error) {
+	out := new(StructuredDataResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/StructuredData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *crawlerClient) Results(ctx context.Context, in *ResultsRequest, opts ...grpc.CallOption) (Crawler_ResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Crawler_serviceDesc.Streams[1], "/crawler.v1.Crawler/Results", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &crawlerResultsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func NewCrawlerClient(cc *grpc.ClientConn) CrawlerClient {
-	return &crawlerClient{cc}
+type Crawler_ResultsClient interface {
+	Recv() (*Record, error)
+	grpc.ClientStream
 }
 
-func (c *crawlerClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
-	out := new(StartResponse)
-	err := grpc.Invoke(ctx, "/crawler.v1.Crawler/Start", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type crawlerResultsClient struct {
+	grpc.ClientStream
 }
 
-func (c *crawlerClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error) {
-	out := new(StopResponse)
-	err := grpc.Invoke(ctx, "/crawler.v1.Crawler/Stop", in, out, c.cc, opts...)
-	if err != nil {
+func (x *crawlerResultsClient) Recv() (*Record, error) {
+	m := new(Record)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *crawlerClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
-	out := new(ListResponse)
-	err := grpc.Invoke(ctx, "/crawler.v1.Crawler/List", in, out, c.cc, opts...)
+func (c *crawlerClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/crawler.v1.Crawler/Search", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Crawler service
-
+// CrawlerServer is the server API for Crawler service.
 type CrawlerServer interface {
 	// Start signals the service to start crawling the given URL.
 	Start(context.Context, *StartRequest) (*StartResponse, error)
-	// Stop signals the service to stop crawling the given URL.
+	// Stop signals the service to stop crawling the given URL. Stopping a crawl
+	// that has already finished succeeds without doing anything.
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 	// Show the current site tree for all the given URLs.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Delete removes the stored site trees for the given URL.
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// CreateSchedule creates a schedule that starts crawling a URL at regular
+	// times.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// ListSchedules shows all of the schedules and their recent runs.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// DeleteSchedule removes a schedule. Crawls that it has already started are
+	// not stopped.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// Diff compares two crawls of the same URL and reports the pages that were
+	// added, removed and changed.
+	Diff(context.Context, *DiffRequest) (*DiffResponse, error)
+	// SitemapReport compares the pages listed in a site's sitemaps with the
+	// pages that were reachable by following links. The crawl must have been
+	// started with use_sitemaps.
+	SitemapReport(context.Context, *SitemapReportRequest) (*SitemapReportResponse, error)
+	// Export converts a crawl into another format, such as a sitemap. The files
+	// are streamed in chunks, so that large exports aren't limited by the size
+	// of a single message.
+	Export(*ExportRequest, Crawler_ExportServer) error
+	// AssetReport summarizes the images, scripts, stylesheets and media used by
+	// the pages of a crawl.
+	AssetReport(context.Context, *AssetReportRequest) (*AssetReportResponse, error)
+	// ExternalLinkReport summarizes the links from a crawl to other sites.
+	ExternalLinkReport(context.Context, *ExternalLinkReportRequest) (*ExternalLinkReportResponse, error)
+	// Audit checks the pages of a crawl for common SEO problems, such as
+	// missing titles, duplicate descriptions and thin content.
+	Audit(context.Context, *AuditRequest) (*AuditResponse, error)
+	// CanonicalReport reports the problems with the canonical and hreflang
+	// links of a crawl's pages.
+	CanonicalReport(context.Context, *CanonicalReportRequest) (*CanonicalReportResponse, error)
+	// DuplicateReport groups the pages of a crawl that have the same or nearly
+	// the same text.
+	DuplicateReport(context.Context, *DuplicateReportRequest) (*DuplicateReportResponse, error)
+	// StructuredData returns the JSON-LD, Microdata, OpenGraph and Twitter card
+	// metadata extracted from the pages of a crawl.
+	StructuredData(context.Context, *StructuredDataRequest) (*StructuredDataResponse, error)
+	// Results streams the records extracted from the pages of a crawl by the
+	// crawl's extraction rules.
+	Results(*ResultsRequest, Crawler_ResultsServer) error
+	// Search finds the pages of a crawl whose visible text matches a query.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+}
+
+// UnimplementedCrawlerServer can be embedded to have forward compatible implementations.
+type UnimplementedCrawlerServer struct {
+}
+
+func (*UnimplementedCrawlerServer) Start(ctx context.Context, req *StartRequest) (*StartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (*UnimplementedCrawlerServer) Stop(ctx context.Context, req *StopRequest) (*StopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (*UnimplementedCrawlerServer) List(ctx context.Context, req *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCrawlerServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedCrawlerServer) CreateSchedule(ctx context.Context, req *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedCrawlerServer) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (*UnimplementedCrawlerServer) DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedCrawlerServer) Diff(ctx context.Context, req *DiffRequest) (*DiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (*UnimplementedCrawlerServer) SitemapReport(ctx context.Context, req *SitemapReportRequest) (*SitemapReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SitemapReport not implemented")
+}
+func (*UnimplementedCrawlerServer) Export(req *ExportRequest, srv Crawler_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedCrawlerServer) AssetReport(ctx context.Context, req *AssetReportRequest) (*AssetReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetReport not implemented")
+}
+func (*UnimplementedCrawlerServer) ExternalLinkReport(ctx context.Context, req *ExternalLinkReportRequest) (*ExternalLinkReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalLinkReport not implemented")
+}
+func (*UnimplementedCrawlerServer) Audit(ctx context.Context, req *AuditRequest) (*AuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Audit not implemented")
+}
+func (*UnimplementedCrawlerServer) CanonicalReport(ctx context.Context, req *CanonicalReportRequest) (*CanonicalReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalReport not implemented")
+}
+func (*UnimplementedCrawlerServer) DuplicateReport(ctx context.Context, req *DuplicateReportRequest) (*DuplicateReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateReport not implemented")
+}
+func (*UnimplementedCrawlerServer) StructuredData(ctx context.Context, req *StructuredDataRequest) (*StructuredDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StructuredData not implemented")
+}
+func (*UnimplementedCrawlerServer) Results(req *ResultsRequest, srv Crawler_ResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Results not implemented")
+}
+func (*UnimplementedCrawlerServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
 
 func RegisterCrawlerServer(s *grpc.Server, srv CrawlerServer) {
@@ -461,6 +5527,282 @@ func _Crawler_List_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Crawler_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).Diff(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_SitemapReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SitemapReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).SitemapReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/SitemapReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).SitemapReport(ctx, req.(*SitemapReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CrawlerServer).Export(m, &crawlerExportServer{stream})
+}
+
+type Crawler_ExportServer interface {
+	Send(*ExportResponse) error
+	grpc.ServerStream
+}
+
+type crawlerExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *crawlerExportServer) Send(m *ExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Crawler_AssetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).AssetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/AssetReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).AssetReport(ctx, req.(*AssetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_ExternalLinkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExternalLinkReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).ExternalLinkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/ExternalLinkReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).ExternalLinkReport(ctx, req.(*ExternalLinkReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_Audit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).Audit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/Audit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).Audit(ctx, req.(*AuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_CanonicalReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CanonicalReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).CanonicalReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/CanonicalReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).CanonicalReport(ctx, req.(*CanonicalReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_DuplicateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).DuplicateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/DuplicateReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).DuplicateReport(ctx, req.(*DuplicateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_StructuredData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StructuredDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).StructuredData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/StructuredData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).StructuredData(ctx, req.(*StructuredDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Crawler_Results_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResultsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CrawlerServer).Results(m, &crawlerResultsServer{stream})
+}
+
+type Crawler_ResultsServer interface {
+	Send(*Record) error
+	grpc.ServerStream
+}
+
+type crawlerResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *crawlerResultsServer) Send(m *Record) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Crawler_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CrawlerServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/crawler.v1.Crawler/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CrawlerServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Crawler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "crawler.v1.Crawler",
 	HandlerType: (*CrawlerServer)(nil),
@@ -477,33 +5819,70 @@ var _Crawler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Crawler_List_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _Crawler_Delete_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Crawler_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _Crawler_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _Crawler_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _Crawler_Diff_Handler,
+		},
+		{
+			MethodName: "SitemapReport",
+			Handler:    _Crawler_SitemapReport_Handler,
+		},
+		{
+			MethodName: "AssetReport",
+			Handler:    _Crawler_AssetReport_Handler,
+		},
+		{
+			MethodName: "ExternalLinkReport",
+			Handler:    _Crawler_ExternalLinkReport_Handler,
+		},
+		{
+			MethodName: "Audit",
+			Handler:    _Crawler_Audit_Handler,
+		},
+		{
+			MethodName: "CanonicalReport",
+			Handler:    _Crawler_CanonicalReport_Handler,
+		},
+		{
+			MethodName: "DuplicateReport",
+			Handler:    _Crawler_DuplicateReport_Handler,
+		},
+		{
+			MethodName: "StructuredData",
+			Handler:    _Crawler_StructuredData_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Crawler_Search_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Crawler_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Results",
+			Handler:       _Crawler_Results_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "crawler.proto",
 }
-
-func init() { proto.RegisterFile("crawler.proto", fileDescriptor_crawler_297b505cae0bb36a) }
-
-var fileDescriptor_crawler_297b505cae0bb36a = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcf, 0x4f, 0x83, 0x30,
-	0x14, 0x1e, 0x1b, 0xea, 0xf6, 0xd8, 0x74, 0x69, 0x4c, 0xac, 0xbb, 0x48, 0xaa, 0x26, 0x3b, 0x18,
-	0x16, 0xb7, 0xa3, 0x89, 0x87, 0xed, 0xe2, 0xc1, 0x13, 0x78, 0xf2, 0x62, 0x18, 0xbe, 0x6c, 0x44,
-	0x06, 0xd8, 0x16, 0xf7, 0x07, 0xfa, 0x8f, 0x99, 0x96, 0x32, 0x21, 0xe8, 0xed, 0x7b, 0x7c, 0x3f,
-	0x78, 0xfd, 0x5a, 0x18, 0x45, 0x3c, 0xdc, 0x27, 0xc8, 0xbd, 0x9c, 0x67, 0x32, 0x23, 0x50, 0x8d,
-	0x5f, 0xf7, 0xcc, 0x85, 0x61, 0x20, 0x43, 0x2e, 0x7d, 0xfc, 0x2c, 0x50, 0x48, 0x32, 0x86, 0x5e,
-	0xc1, 0x13, 0x6a, 0xb9, 0xd6, 0x74, 0xe0, 0x2b, 0xc8, 0xce, 0x60, 0x64, 0x14, 0x22, 0xcf, 0x52,
-	0x81, 0xec, 0x0a, 0x9c, 0x40, 0x66, 0xf9, 0xff, 0x8e, 0x53, 0x95, 0xa9, 0x04, 0xc6, 0x30, 0x02,
-	0xe7, 0x39, 0x16, 0xd5, 0x2f, 0xd8, 0x0a, 0x86, 0xe5, 0x58, 0xd2, 0x64, 0x01, 0x20, 0x62, 0x89,
-	0x6f, 0x92, 0x23, 0x0a, 0x6a, 0xb9, 0xbd, 0xa9, 0x33, 0x3f, 0xf7, 0x7e, 0x77, 0xf4, 0x82, 0x58,
-	0xe2, 0x0b, 0x47, 0xf4, 0x07, 0xc2, 0x20, 0xc1, 0x96, 0xd0, 0xaf, 0x3e, 0xb7, 0x37, 0x20, 0x37,
-	0x60, 0xab, 0x34, 0xda, 0x75, 0xad, 0xa9, 0x33, 0x1f, 0xd7, 0xc3, 0x74, 0x90, 0x66, 0xd9, 0x13,
-	0xd8, 0xda, 0x4f, 0xc0, 0x4e, 0xc3, 0x1d, 0x9a, 0x00, 0x8d, 0xc9, 0x1d, 0xf4, 0xa3, 0x6d, 0x9c,
-	0xbc, 0x73, 0x4c, 0x69, 0x57, 0xaf, 0xd4, 0x4e, 0x39, 0x28, 0xe6, 0xdf, 0x16, 0x9c, 0xac, 0x4a,
-	0x96, 0x3c, 0xc2, 0x91, 0xee, 0x8b, 0xd0, 0xc6, 0x19, 0x6a, 0x25, 0x4f, 0x2e, 0xff, 0x60, 0x4c,
-	0x57, 0x1d, 0xf2, 0x00, 0xb6, 0x6a, 0x8f, 0x5c, 0x34, 0x45, 0x87, 0xc2, 0x27, 0xb4, 0x4d, 0xd4,
-	0xcd, 0xaa, 0xdb, 0xa6, 0xb9, 0x56, 0x7e, 0xd3, 0x5c, 0xbf, 0x06, 0xd6, 0x59, 0xde, 0xbe, 0x5e,
-	0x6f, 0x62, 0xb9, 0x2d, 0xd6, 0x5e, 0x94, 0xed, 0x66, 0x7b, 0xce, 0xd3, 0x99, 0x11, 0xcf, 0xf2,
-	0x8f, 0x4d, 0x85, 0xd7, 0xc7, 0xfa, 0x15, 0x2d, 0x7e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x37, 0x0a,
-	0x1b, 0x88, 0x56, 0x02, 0x00, 0x00,
-}